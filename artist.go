@@ -0,0 +1,264 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SimpleArtist contains basic info about an artist.
+type SimpleArtist struct {
+	Name string `json:"name"`
+	ID   ID     `json:"id"`
+	// The Spotify URI for the artist.
+	URI URI `json:"uri"`
+	// A link to the Web API enpoint providing full details of the artist.
+	Endpoint     string      `json:"href"`
+	ExternalURLs ExternalURL `json:"external_urls"`
+}
+
+// FullArtist provides extra artist data in addition to what is provided by SimpleArtist.
+type FullArtist struct {
+	SimpleArtist
+	// The popularity of the artist, expressed as an integer between 0 and 100.
+	// The artist's popularity is calculated from the popularity of the artist's tracks.
+	Popularity int `json:"popularity"`
+	// A list of genres the artist is associated with.  For example, "Prog Rock"
+	// or "Post-Grunge".  If not yet classified, the slice is empty.
+	Genres    []string `json:"genres"`
+	Followers Followers
+	// Images of the artist in various sizes, widest first.
+	Images []Image `json:"images"`
+}
+
+// GetArtist is a wrapper around DefaultClient.GetArtist.
+func GetArtist(id ID) (*FullArtist, error) {
+	return DefaultClient.GetArtist(id)
+}
+
+// GetArtist gets Spotify catalog information for a single artist, given its Spotify ID.
+func (c *Client) GetArtist(id ID) (*FullArtist, error) {
+	return c.GetArtistWithContext(context.Background(), id)
+}
+
+// GetArtistWithContext is like GetArtist, but it accepts a context.Context
+// that can be used to cancel or time out the request.
+func (c *Client) GetArtistWithContext(ctx context.Context, id ID) (*FullArtist, error) {
+	spotifyURL := fmt.Sprintf("%sartists/%s", baseAddress, id)
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var a FullArtist
+	err = json.NewDecoder(resp.Body).Decode(&a)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// GetArtists is a wrapper around DefaultClient.GetArtists.
+func GetArtists(ids ...ID) ([]*FullArtist, error) {
+	return DefaultClient.GetArtists(ids...)
+}
+
+// GetArtists gets spotify catalog information for several artists based on their
+// Spotify IDs.  It supports up to 50 artists in a single call.  Artists are
+// returned in the order requested.  If an artist is not found, that position
+// in the result will be nil.  Duplicate IDs will result in duplicate artists
+// in the result.
+func (c *Client) GetArtists(ids ...ID) ([]*FullArtist, error) {
+	return c.GetArtistsWithContext(context.Background(), ids...)
+}
+
+// GetArtistsWithContext is like GetArtists, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) GetArtistsWithContext(ctx context.Context, ids ...ID) ([]*FullArtist, error) {
+	spotifyURL := fmt.Sprintf("%sartists?ids=%s", baseAddress, strings.Join(toStringSlice(ids), ","))
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var a struct {
+		Artists []*FullArtist
+	}
+	err = json.NewDecoder(resp.Body).Decode(&a)
+	if err != nil {
+		return nil, err
+	}
+	return a.Artists, nil
+}
+
+// GetArtistsTopTracks is a wrapper around DefaultClient.GetArtistsTopTracks.
+func GetArtistsTopTracks(artistID ID, country string) ([]FullTrack, error) {
+	return DefaultClient.GetArtistsTopTracks(artistID, country)
+}
+
+// GetArtistsTopTracks gets Spotify catalog information about an artist's top
+// tracks in a particular country.  It returns a maximum of 10 tracks.  The
+// country is specified as an ISO 3166-1 alpha-2 country code.
+func (c *Client) GetArtistsTopTracks(artistID ID, country string) ([]FullTrack, error) {
+	return c.GetArtistsTopTracksWithContext(context.Background(), artistID, country)
+}
+
+// GetArtistsTopTracksWithContext is like GetArtistsTopTracks, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) GetArtistsTopTracksWithContext(ctx context.Context, artistID ID, country string) ([]FullTrack, error) {
+	spotifyURL := fmt.Sprintf("%sartists/%s/top-tracks?country=%s", baseAddress, artistID, country)
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var t struct {
+		Tracks []FullTrack `json:"tracks"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&t)
+	if err != nil {
+		return nil, err
+	}
+	return t.Tracks, nil
+}
+
+// GetRelatedArtists is a wrapper around DefaultClient.GetRelatedArtists.
+func GetRelatedArtists(id ID) ([]FullArtist, error) {
+	return DefaultClient.GetRelatedArtists(id)
+}
+
+// GetRelatedArtists gets Spotify catalog information about artists similar to a
+// given artist.  Similarity is based on analysis of the Spotify community's
+// listening history.  This function returns up to 20 artists that are considered
+// related to the specified artist.
+func (c *Client) GetRelatedArtists(id ID) ([]FullArtist, error) {
+	return c.GetRelatedArtistsWithContext(context.Background(), id)
+}
+
+// GetRelatedArtistsWithContext is like GetRelatedArtists, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) GetRelatedArtistsWithContext(ctx context.Context, id ID) ([]FullArtist, error) {
+	spotifyURL := fmt.Sprintf("%sartists/%s/related-artists", baseAddress, id)
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var a struct {
+		Artists []FullArtist `json:"artists"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&a)
+	if err != nil {
+		return nil, err
+	}
+	return a.Artists, nil
+}
+
+// GetArtistAlbums is a wrapper around DefaultClient.GetArtistAlbums.
+func GetArtistAlbums(artistID ID) (*SimpleAlbumPage, error) {
+	return DefaultClient.GetArtistAlbums(artistID)
+}
+
+// GetArtistAlbums gets Spotify catalog information about an artist's albums.
+// It is equivalent to GetArtistAlbumsOpt(artistID, nil, nil).
+func (c *Client) GetArtistAlbums(artistID ID) (*SimpleAlbumPage, error) {
+	return c.GetArtistAlbumsOpt(artistID, nil, nil)
+}
+
+// GetArtistAlbumsWithContext is like GetArtistAlbums, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) GetArtistAlbumsWithContext(ctx context.Context, artistID ID) (*SimpleAlbumPage, error) {
+	return c.GetArtistAlbumsOptWithContext(ctx, artistID, nil, nil)
+}
+
+// GetArtistAlbumsOpt is a wrapper around DefaultClient.GetArtistAlbumsOpt
+func GetArtistAlbumsOpt(artistID ID, options *Options, t *AlbumType) (*SimpleAlbumPage, error) {
+	return DefaultClient.GetArtistAlbumsOpt(artistID, options, t)
+}
+
+// GetArtistAlbumsOpt is just like GetArtistAlbums, but it accepts optional
+// parameters used to filter and sort the result.
+//
+// The AlbumType argument can be used to find a particular type of album.  Search
+// for multiple types by OR-ing the types together.
+func (c *Client) GetArtistAlbumsOpt(artistID ID, options *Options, t *AlbumType) (*SimpleAlbumPage, error) {
+	return c.GetArtistAlbumsOptWithContext(context.Background(), artistID, options, t)
+}
+
+// GetArtistAlbumsOptWithContext is like GetArtistAlbumsOpt, but it accepts
+// a context.Context that can be used to cancel or time out the request.
+func (c *Client) GetArtistAlbumsOptWithContext(ctx context.Context, artistID ID, options *Options, t *AlbumType) (*SimpleAlbumPage, error) {
+	spotifyURL := fmt.Sprintf("%sartists/%s/albums", baseAddress, artistID)
+	// add optional query string if options were specified
+	values := url.Values{}
+	if t != nil {
+		values.Set("album_type", t.encode())
+	}
+	if options != nil {
+		if options.Country != nil {
+			values.Set("market", *options.Country)
+		} else {
+			// if the market is not specified, Spotify will likely return a lot
+			// of duplicates (one for each market in which the album is available)
+			// - prevent this behavior by falling back to the US by default
+			// TODO: would this ever be the desired behavior?
+			values.Set("market", CountryUSA)
+		}
+		if options.Limit != nil {
+			values.Set("limit", strconv.Itoa(*options.Limit))
+		}
+		if options.Offset != nil {
+			values.Set("offset", strconv.Itoa(*options.Offset))
+		}
+	}
+	if query := values.Encode(); query != "" {
+		spotifyURL += "?" + query
+	}
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var p SimpleAlbumPage
+	err = json.NewDecoder(resp.Body).Decode(&p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// AuthURL is the URL to Spotify Accounts Service's OAuth2 endpoint.
+	AuthURL = "https://accounts.spotify.com/authorize"
+	// TokenURL is the URL to the Spotify Accounts Service's OAuth2
+	// token endpoint.
+	TokenURL = "https://accounts.spotify.com/api/token"
+)
+
+// Scope identifies a permission that an application can request as part of
+// the OAuth2 authorization process.  The set of scopes you pass in your
+// authentication request determines what access the user is asked to grant.
+type Scope string
+
+// Scopes let you specify exactly which types of data your application wants to access.
+// The set of scopes you pass in your authentication request determines what access the
+// permissions the user is asked to grant.
+const (
+	// ScopePlaylistReadPrivate seeks permission to read
+	// a user's private playlists.
+	ScopePlaylistReadPrivate Scope = "playlist-read-private"
+	// ScopePlaylistModifyPublic seeks write access
+	// to a user's public playlists.
+	ScopePlaylistModifyPublic Scope = "playlist-modify-public"
+	// ScopePlaylistModifyPrivate seeks write access to
+	// a user's private playlists.
+	ScopePlaylistModifyPrivate Scope = "playlist-modify-private"
+	// ScopePlaylistReadCollaborative seeks permission to
+	// access a user's collaborative playlists.
+	ScopePlaylistReadCollaborative Scope = "playlist-read-collaborative"
+	// ScopeUserFollowModify seeks write/delete access to
+	// the list of artists and other users that a user follows.
+	ScopeUserFollowModify Scope = "user-follow-modify"
+	// ScopeUserFollowRead seeks read access to the list of
+	// artists and other users that a user follows.
+	ScopeUserFollowRead Scope = "user-follow-read"
+	// ScopeUserLibraryModify seeks write/delete acess to a
+	// user's "Your Music" library.
+	ScopeUserLibraryModify Scope = "user-library-modify"
+	// ScopeUserLibraryRead seeks read access to a user's "Your Music" library.
+	ScopeUserLibraryRead Scope = "user-library-read"
+	// ScopeUserReadPrivate seeks read access to a user's
+	// subsription details (type of user account).
+	ScopeUserReadPrivate Scope = "user-read-private"
+	// ScopeUserReadEmail seeks read access to a user's email address.
+	ScopeUserReadEmail Scope = "user-read-email"
+	// ScopeUserReadBirthdate seeks read access to a user's birthdate.
+	ScopeUserReadBirthdate Scope = "user-read-birthdate"
+	// ScopeUGCImageUpload seeks permission to upload images,
+	// for example as a custom playlist cover.
+	ScopeUGCImageUpload Scope = "ugc-image-upload"
+	// ScopeStreaming seeks permission to control playback of a
+	// Spotify track via the Web Playback SDK. This scope is only
+	// available to Spotify Premium users.
+	ScopeStreaming Scope = "streaming"
+	// ScopeUserTopRead seeks read access to a user's top artists and tracks.
+	ScopeUserTopRead Scope = "user-top-read"
+	// ScopeUserReadRecentlyPlayed seeks read access to a
+	// user's recently played tracks.
+	ScopeUserReadRecentlyPlayed Scope = "user-read-recently-played"
+	// ScopeUserReadPlaybackState seeks read access to a
+	// user's current playback state, including the currently
+	// playing track, progress, and active device.
+	ScopeUserReadPlaybackState Scope = "user-read-playback-state"
+	// ScopeUserModifyPlaybackState seeks write access to control
+	// playback on a user's account, such as pause, skip, and seek.
+	ScopeUserModifyPlaybackState Scope = "user-modify-playback-state"
+	// ScopeUserReadCurrentlyPlaying seeks read access to the
+	// track currently being played by a user.
+	ScopeUserReadCurrentlyPlaying Scope = "user-read-currently-playing"
+	// ScopeUserReadPlaybackPosition seeks read access to a user's
+	// playback position in a content item, such as an episode of a show.
+	ScopeUserReadPlaybackPosition Scope = "user-read-playback-position"
+)
+
+// ErrMissingScope is returned by a Client method when the client was built
+// from an Authenticator whose scopes are known and don't include a scope
+// the method requires.  Clients with no known scopes skip this check.
+// This check happens locally, before any request is
+// sent to Spotify, so callers can distinguish a missing scope from an
+// ordinary network or authentication Error.
+type ErrMissingScope struct {
+	// Required is the scope the caller needed but didn't have.
+	Required Scope
+}
+
+func (e ErrMissingScope) Error() string {
+	return "spotify: missing required scope " + string(e.Required)
+}
+
+func scopeSet(scopes []Scope) map[Scope]bool {
+	if len(scopes) == 0 {
+		return nil
+	}
+	set := make(map[Scope]bool, len(scopes))
+	for _, s := range scopes {
+		set[s] = true
+	}
+	return set
+}
+
+// Authenticator provides convenience functions for implementing the OAuth2 flow.
+// You should always use `NewAuthenticator` to make them.
+//
+// Example:
+//
+//	a := spotify.NewAuthenticator(redirectURL, spotify.ScopeUserLibraryRead, spotify.ScopeUserFollowRead)
+//	// direct user to Spotify to log in
+//	http.Redirect(w, r, a.AuthURL("state-string"), http.StatusFound)
+//
+//	// then, in redirect handler:
+//	token, err := a.Token(state, r)
+//	client := a.NewClient(token)
+type Authenticator struct {
+	config *oauth2.Config
+	scopes map[Scope]bool
+}
+
+// NewAuthenticator creates an authenticator which is used to implement the
+// OAuth2 authorization flow.  The redirectURL must exactly match one of the
+// URLs specified in your Spotify developer account.
+//
+// By default, NewAuthenticator pulls your client ID and secret key from the
+// SPOTIFY_ID and SPOTIFY_SECRET environment variables.  If you'd like to provide
+// them from some other source, you can call `SetAuthInfo(id, key)` on the
+// returned authenticator.
+func NewAuthenticator(redirectURL string, scopes ...Scope) Authenticator {
+	strs := make([]string, len(scopes))
+	for i, s := range scopes {
+		strs[i] = string(s)
+	}
+	cfg := &oauth2.Config{
+		ClientID:     os.Getenv("SPOTIFY_ID"),
+		ClientSecret: os.Getenv("SPOTIFY_SECRET"),
+		RedirectURL:  redirectURL,
+		Scopes:       strs,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  AuthURL,
+			TokenURL: TokenURL,
+		},
+	}
+	return Authenticator{
+		config: cfg,
+		scopes: scopeSet(scopes),
+	}
+}
+
+// SetAuthInfo overwrites the client ID and secret key used by the authenticator.
+// You can use this if you don't want to store this information in environment
+// variables.  The scopes, if any are given, replace the scopes passed to
+// NewAuthenticator.
+func (a *Authenticator) SetAuthInfo(clientID, secretKey string, scopes ...Scope) {
+	a.config.ClientID = clientID
+	a.config.ClientSecret = secretKey
+	if len(scopes) > 0 {
+		strs := make([]string, len(scopes))
+		for i, s := range scopes {
+			strs[i] = string(s)
+		}
+		a.config.Scopes = strs
+		a.scopes = scopeSet(scopes)
+	}
+}
+
+// AuthURL returns a URL to the the Spotify Accounts Service's OAuth2 endpoint.
+//
+// State is a token to protect the user from CSRF attacks.  You should pass the
+// same state to `Token`, where it will be validated.  For more info, refer to
+// http://tools.ietf.org/html/rfc6749#section-10.12.
+func (a Authenticator) AuthURL(state string) string {
+	return a.config.AuthCodeURL(state)
+}
+
+// Token pulls an authorization code from an HTTP request and attempts to exchange
+// it for an access token.  The standard use case is to call Token from the handler
+// that handles requests to your application's redirect URL.
+func (a Authenticator) Token(state string, r *http.Request) (*oauth2.Token, error) {
+	values := r.URL.Query()
+	if e := values.Get("error"); e != "" {
+		return nil, errors.New("spotify: auth failed - " + e)
+	}
+	code := values.Get("code")
+	if code == "" {
+		return nil, errors.New("spotify: didn't get access code")
+	}
+	actualState := values.Get("state")
+	if actualState != state {
+		return nil, errors.New("spotify: redirect state parameter doesn't match")
+	}
+	return a.config.Exchange(oauth2.NoContext, code)
+}
+
+// Exchange is like Token, except it allows you to manually specify the access
+// code instead of pulling it out of an HTTP request.
+func (a Authenticator) Exchange(code string) (*oauth2.Token, error) {
+	return a.config.Exchange(oauth2.NoContext, code)
+}
+
+// NewClient creates a Client that will use the specified access token for its API requests.
+func (a Authenticator) NewClient(token *oauth2.Token) Client {
+	return Client{
+		http:   a.config.Client(oauth2.NoContext, token),
+		scopes: a.scopes,
+	}
+}
+
+// ClientCredentialsToken obtains a token using the Client Credentials OAuth2
+// flow, also known as two-legged OAuth.  This flow is appropriate for
+// server-to-server requests that don't act on behalf of a particular user,
+// such as catalog lookups and searches.  Endpoints that require a user's
+// authorization (for example Follow or CurrentUser) will still fail with an
+// Error when called with a token obtained this way.
+//
+// The token is acquired directly from TokenURL using the Authenticator's
+// client ID and secret, so the RedirectURL and Scopes passed to
+// NewAuthenticator are ignored.
+func (a Authenticator) ClientCredentialsToken(ctx context.Context) (*oauth2.Token, error) {
+	return a.clientCredentialsConfig().Token(ctx)
+}
+
+// NewClientCredentialsClient is like ClientCredentialsToken, but returns a
+// ready-to-use Client instead of a raw token.  The returned Client's
+// underlying token source caches the token and transparently fetches a
+// replacement once it expires, so long-running services can keep reusing
+// the same Client without re-authenticating on every call.
+func (a Authenticator) NewClientCredentialsClient(ctx context.Context) Client {
+	return Client{
+		http: a.clientCredentialsConfig().Client(ctx),
+	}
+}
+
+func (a Authenticator) clientCredentialsConfig() *clientcredentials.Config {
+	return &clientcredentials.Config{
+		ClientID:     a.config.ClientID,
+		ClientSecret: a.config.ClientSecret,
+		TokenURL:     a.config.Endpoint.TokenURL,
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestClientCredentialsToken(t *testing.T) {
+	json := `{
+		"access_token": "NgCXRKc9...MzYjw",
+		"token_type": "bearer",
+		"expires_in": 3600
+	}`
+	hc := &http.Client{Transport: newStringRoundTripper(http.StatusOK, json)}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, hc)
+
+	auth := NewAuthenticator("")
+	auth.SetAuthInfo("clientid", "secret")
+
+	token, err := auth.ClientCredentialsToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "NgCXRKc9...MzYjw" {
+		t.Error("Got unexpected access token", token.AccessToken)
+	}
+	if token.TokenType != "bearer" {
+		t.Error("Got unexpected token type", token.TokenType)
+	}
+}
+
+func TestNewClientCredentialsClient(t *testing.T) {
+	tokenJSON := `{
+		"access_token": "NgCXRKc9...MzYjw",
+		"token_type": "bearer",
+		"expires_in": 3600
+	}`
+	artistJSON := `{ "name": "Pitbull", "id": "0TnOYISbd1XYRBk9myaseg" }`
+	rt := newSequenceRoundTripper(
+		&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(tokenJSON))},
+		&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(artistJSON))},
+	)
+	hc := &http.Client{Transport: rt}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, hc)
+
+	auth := NewAuthenticator("")
+	auth.SetAuthInfo("clientid", "secret")
+
+	client := auth.NewClientCredentialsClient(ctx)
+	artist, err := client.GetArtist(ID("0TnOYISbd1XYRBk9myaseg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if artist.Name != "Pitbull" {
+		t.Errorf("Got %s, wanted Pitbull", artist.Name)
+	}
+	if got := rt.responses[1].Request.Header.Get("Authorization"); got != "Bearer NgCXRKc9...MzYjw" {
+		t.Errorf("Expected the API request to carry the fetched bearer token, got %q", got)
+	}
+}
+
+func TestRequireScopeMissing(t *testing.T) {
+	auth := NewAuthenticator("", ScopeUserReadEmail)
+	client := auth.NewClient(&oauth2.Token{AccessToken: "sample"})
+
+	err := client.Follow(ID("exampleuser01"))
+	merr, ok := err.(ErrMissingScope)
+	if !ok {
+		t.Fatalf("Expected ErrMissingScope, got %#v", err)
+	}
+	if merr.Required != ScopeUserFollowModify {
+		t.Errorf("Expected required scope %s, got %s", ScopeUserFollowModify, merr.Required)
+	}
+}
+
+func TestRequireScopeGranted(t *testing.T) {
+	auth := NewAuthenticator("", ScopeUserFollowModify)
+	client := auth.NewClient(&oauth2.Token{AccessToken: "sample"})
+	client.http.Transport = newStringRoundTripper(http.StatusNoContent, "")
+
+	if err := client.Follow(ID("exampleuser01")); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+// ISO 3166-1 alpha 2 country codes.
+//
+// see: https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+const (
+	CountryArgentina          = "AR"
+	CountryAustralia          = "AU"
+	CountryAustria            = "AT"
+	CountryBelarus            = "BY"
+	CountryBelgium            = "BE"
+	CountryBrazil             = "BR"
+	CountryCanada             = "CA"
+	CountryChile              = "CL"
+	CountryChina              = "CN"
+	CountryGermany            = "DE"
+	CountryHongKong           = "HK"
+	CountryIreland            = "IE"
+	CountryIndia              = "IN"
+	CountryItaly              = "IT"
+	CountryJapan              = "JP"
+	CountrySpain              = "ES"
+	CountryFinland            = "FI"
+	CountryFrance             = "FR"
+	CountryMexico             = "MX"
+	CountryNewZealand         = "NZ"
+	CountryRussia             = "RU"
+	CountrySwitzerland        = "CH"
+	CountryUnitedArabEmirates = "AE"
+	CountryUnitedKingdom      = "GB"
+	CountryUSA                = "US"
+)
@@ -0,0 +1,133 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import "context"
+
+// TrackIterator auto-paginates over a user's saved tracks, fetching
+// additional pages from the Web API as the current page is exhausted.
+// Use CurrentUsersTracksAll to obtain one.
+type TrackIterator struct {
+	client *Client
+	page   *SavedTrackPage
+	index  int
+	err    error
+}
+
+// CurrentUsersTracksAll is like CurrentUsersTracks, but it returns a
+// TrackIterator that transparently fetches subsequent pages as needed,
+// instead of a single page of results.
+func (c *Client) CurrentUsersTracksAll(ctx context.Context) (*TrackIterator, error) {
+	page, err := c.CurrentUsersTracksOptWithContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TrackIterator{client: c, page: page, index: -1}, nil
+}
+
+// Next advances the iterator to the next track, fetching the next page of
+// results from the Web API if the current page has been exhausted.  It
+// returns false once there are no more tracks or a page fetch fails; use
+// Err to tell the two cases apart.
+func (it *TrackIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page.Tracks) {
+		if it.page.Next == "" {
+			return false
+		}
+		var next SavedTrackPage
+		if err := it.client.getPageContext(ctx, it.page.Next, &next); err != nil {
+			it.err = err
+			return false
+		}
+		it.page = &next
+		it.index = 0
+	}
+	return true
+}
+
+// Track returns the track at the iterator's current position.  It is only
+// valid to call after a call to Next that returned true.
+func (it *TrackIterator) Track() SavedTrack {
+	return it.page.Tracks[it.index]
+}
+
+// Err returns the error, if any, that caused Next to return false.  It
+// returns nil if the iterator was simply exhausted.
+func (it *TrackIterator) Err() error {
+	return it.err
+}
+
+// ArtistCursorIterator auto-paginates over a cursor-based set of artists,
+// fetching additional pages from the Web API as the current page is
+// exhausted.  Use CurrentUsersFollowedArtistsAll to obtain one.
+type ArtistCursorIterator struct {
+	client *Client
+	page   *FullArtistCursorPage
+	index  int
+	err    error
+}
+
+// CurrentUsersFollowedArtistsAll is like CurrentUsersFollowedArtists, but it
+// returns an ArtistCursorIterator that transparently fetches subsequent
+// pages as needed, instead of a single page of results.
+func (c *Client) CurrentUsersFollowedArtistsAll(ctx context.Context) (*ArtistCursorIterator, error) {
+	page, err := c.CurrentUsersFollowedArtistsOptWithContext(ctx, -1, "")
+	if err != nil {
+		return nil, err
+	}
+	return &ArtistCursorIterator{client: c, page: page, index: -1}, nil
+}
+
+// Next advances the iterator to the next artist, fetching the next page of
+// results from the Web API if the current page has been exhausted.  It
+// returns false once there are no more artists or a page fetch fails; use
+// Err to tell the two cases apart.
+func (it *ArtistCursorIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= len(it.page.Artists) {
+		if it.page.Next == "" {
+			return false
+		}
+		var next struct {
+			Artists FullArtistCursorPage `json:"artists"`
+		}
+		if err := it.client.getPageContext(ctx, it.page.Next, &next); err != nil {
+			it.err = err
+			return false
+		}
+		it.page = &next.Artists
+		it.index = 0
+	}
+	return true
+}
+
+// Artist returns the artist at the iterator's current position.  It is
+// only valid to call after a call to Next that returned true.
+func (it *ArtistCursorIterator) Artist() FullArtist {
+	return it.page.Artists[it.index]
+}
+
+// Err returns the error, if any, that caused Next to return false.  It
+// returns nil if the iterator was simply exhausted.
+func (it *ArtistCursorIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,147 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func jsonResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestTrackIteratorPagination(t *testing.T) {
+	page1 := `{
+		"href": "https://api.spotify.com/v1/me/tracks",
+		"limit": 1,
+		"offset": 0,
+		"total": 2,
+		"next": "https://api.spotify.com/v1/me/tracks?offset=1&limit=1",
+		"previous": null,
+		"items": [ { "added_at": "2015-01-01T00:00:00Z", "track": { "name": "Track One" } } ]
+	}`
+	page2 := `{
+		"href": "https://api.spotify.com/v1/me/tracks?offset=1&limit=1",
+		"limit": 1,
+		"offset": 1,
+		"total": 2,
+		"next": "",
+		"previous": null,
+		"items": [ { "added_at": "2015-01-02T00:00:00Z", "track": { "name": "Track Two" } } ]
+	}`
+	rt := newSequenceRoundTripper(jsonResponse(page1), jsonResponse(page2))
+	c := &Client{http: &http.Client{Transport: rt}}
+	addDummyAuth(c)
+
+	ctx := context.Background()
+	it, err := c.CurrentUsersTracksAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for it.Next(ctx) {
+		names = append(names, it.Track().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Track One", "Track Two"}
+	if len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Errorf("Expected %v in order, got %v", want, names)
+	}
+}
+
+func TestTrackIteratorSurfacesFetchError(t *testing.T) {
+	page1 := `{
+		"href": "https://api.spotify.com/v1/me/tracks",
+		"limit": 1,
+		"offset": 0,
+		"total": 2,
+		"next": "https://api.spotify.com/v1/me/tracks?offset=1&limit=1",
+		"previous": null,
+		"items": [ { "added_at": "2015-01-01T00:00:00Z", "track": { "name": "Track One" } } ]
+	}`
+	rt := newSequenceRoundTripper(jsonResponse(page1))
+	c := &Client{http: &http.Client{Transport: rt}}
+	addDummyAuth(c)
+
+	ctx := context.Background()
+	it, err := c.CurrentUsersTracksAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !it.Next(ctx) {
+		t.Fatal("Expected the first track to be yielded before the failed fetch")
+	}
+	if it.Next(ctx) {
+		t.Error("Expected Next to return false once fetching the next page fails")
+	}
+	if it.Err() == nil {
+		t.Error("Expected Err to report the failed fetch")
+	}
+}
+
+func TestArtistCursorIteratorPagination(t *testing.T) {
+	page1 := `{
+		"artists": {
+			"href": "https://api.spotify.com/v1/me/following?type=artist",
+			"limit": 1,
+			"total": 2,
+			"next": "https://api.spotify.com/v1/me/following?type=artist&after=1",
+			"cursors": { "after": "1" },
+			"items": [ { "name": "Artist One", "id": "1" } ]
+		}
+	}`
+	page2 := `{
+		"artists": {
+			"href": "https://api.spotify.com/v1/me/following?type=artist&after=1",
+			"limit": 1,
+			"total": 2,
+			"next": "",
+			"cursors": { "after": "" },
+			"items": [ { "name": "Artist Two", "id": "2" } ]
+		}
+	}`
+	rt := newSequenceRoundTripper(jsonResponse(page1), jsonResponse(page2))
+	c := &Client{http: &http.Client{Transport: rt}}
+	addDummyAuth(c)
+
+	ctx := context.Background()
+	it, err := c.CurrentUsersFollowedArtistsAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for it.Next(ctx) {
+		names = append(names, it.Artist().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"Artist One", "Artist Two"}
+	if len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Errorf("Expected %v in order, got %v", want, names)
+	}
+}
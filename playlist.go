@@ -0,0 +1,817 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PlaylistTracks contains details about the tracks in a playlist.
+type PlaylistTracks struct {
+	// A link to the Web API endpoint where full details of
+	// the playlist's tracks can be retrieved.
+	Endpoint string `json:"href"`
+	// The total number of tracks in the playlist.
+	Total uint `json:"total"`
+}
+
+// SimplePlaylist contains basic info about a Spotify playlist.
+type SimplePlaylist struct {
+	// Indicates whether the playlist owner allows others to modify the playlist.
+	// Note: only non-collaborative playlists are currently returned by Spotify's Web API.
+	Collaborative bool        `json:"collaborative"`
+	ExternalURLs  ExternalURL `json:"external_urls"`
+	// A link to the Web API endpoint providing full details of the playlist.
+	Endpoint string `json:"href"`
+	ID       ID     `json:"id"`
+	// The playlist image.  Note: this field is only  returned for modified,
+	// verified playlists. Otherwise the slice is empty.  If returned, the source
+	// URL for the image is temporary and will expire in less than a day.
+	Images   []Image `json:"images"`
+	Name     string  `json:"name"`
+	Owner    User    `json:"owner"`
+	IsPublic bool    `json:"public"`
+	// The version identifier for the current playlist. Can be supplied in other
+	// requests to target a specific playlist version.
+	SnapshotID string `json:"snapshot_id"`
+	// A collection to the Web API endpoint where full details of the playlist's
+	// tracks can be retrieved, along with the total number of tracks in the playlist.
+	Tracks PlaylistTracks `json:"tracks"`
+	URI    URI            `json:"uri"`
+}
+
+// FullPlaylist provides extra playlist data in addition to the data provided by SimplePlaylist.
+type FullPlaylist struct {
+	SimplePlaylist
+	// The playlist description.  Only returned for modified, verified playlists.
+	Description string `json:"description"`
+	// Information about the followers of this playlist.
+	Followers Followers         `json:"followers"`
+	Tracks    PlaylistTrackPage `json:"tracks"`
+}
+
+// PlaylistOptions contains optional parameters that can be used when querying
+// for featured playlists.  Only the non-nil fields are used in the request.
+type PlaylistOptions struct {
+	Options
+	// The desired language, consisting of a lowercase IO 639
+	// language code and an uppercase ISO 3166-1 alpha-2
+	// country code, joined by an underscore.  Provide this
+	// parameter if you want the results returned in a particular
+	// language.  If not specified, the result will be returned
+	// in the Spotify default language (American English).
+	Locale *string
+	// A timestamp in ISO 8601 format (yyyy-MM-ddTHH:mm:ss).
+	// use this paramter to specify the user's local time to
+	// get results tailored for that specific date and time
+	// in the day.  If not provided, the response defaults to
+	// the current UTC time.
+	Timestamp *string
+}
+
+// FeaturedPlaylistsOpt gets a list of playlists featured by Spotify.
+// It accepts a number of optional parameters via the opt argument.
+// This call requires authorization.
+func (c *Client) FeaturedPlaylistsOpt(opt *PlaylistOptions) (message string, playlists *SimplePlaylistPage, e error) {
+	return c.FeaturedPlaylistsOptWithContext(context.Background(), opt)
+}
+
+// FeaturedPlaylistsOptWithContext is like FeaturedPlaylistsOpt, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) FeaturedPlaylistsOptWithContext(ctx context.Context, opt *PlaylistOptions) (message string, playlists *SimplePlaylistPage, e error) {
+	spotifyURL := baseAddress + "browse/featured-playlists"
+	if opt != nil {
+		v := url.Values{}
+		if opt.Locale != nil {
+			v.Set("locale", *opt.Locale)
+		}
+		if opt.Country != nil {
+			v.Set("country", *opt.Country)
+		}
+		if opt.Timestamp != nil {
+			v.Set("timestamp", *opt.Timestamp)
+		}
+		if opt.Limit != nil {
+			v.Set("limit", strconv.Itoa(*opt.Limit))
+		}
+		if opt.Offset != nil {
+			v.Set("offset", strconv.Itoa(*opt.Offset))
+		}
+		if params := v.Encode(); params != "" {
+			spotifyURL += "?" + params
+		}
+	}
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, decodeError(resp)
+	}
+	var result struct {
+		Playlists SimplePlaylistPage `json:"playlists"`
+		Message   string             `json:"message"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return "", nil, err
+	}
+	return result.Message, &result.Playlists, nil
+}
+
+// FeaturedPlaylists gets a list of playlists featured by Spotify.
+// It is equivalent to c.FeaturedPlaylistsOpt(nil).
+func (c *Client) FeaturedPlaylists() (message string, playlists *SimplePlaylistPage, e error) {
+	return c.FeaturedPlaylistsOpt(nil)
+}
+
+// FeaturedPlaylistsWithContext is like FeaturedPlaylists, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) FeaturedPlaylistsWithContext(ctx context.Context) (message string, playlists *SimplePlaylistPage, e error) {
+	return c.FeaturedPlaylistsOptWithContext(ctx, nil)
+}
+
+// FollowPlaylist adds the current user as a follower of the specified
+// playlist.  Any playlist can be followed, regardless of its private/public
+// status, as long as you know the owner and playlist ID.
+//
+// If the public argument is true, then the playlist will be included in the
+// user's public playlists.  To be able to follow playlists privately, the user
+// must have granted the ScopePlaylistModifyPrivate scope.  The
+// ScopePlaylistModifyPublic scope is required to follow playlists publicly.
+func (c *Client) FollowPlaylist(owner ID, playlist ID, public bool) error {
+	return c.FollowPlaylistWithContext(context.Background(), owner, playlist, public)
+}
+
+// FollowPlaylistWithContext is like FollowPlaylist, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) FollowPlaylistWithContext(ctx context.Context, owner ID, playlist ID, public bool) error {
+	spotifyURL := buildFollowURI(owner, playlist)
+	body := strings.NewReader(strconv.FormatBool(public))
+	req, err := http.NewRequestWithContext(ctx, "PUT", spotifyURL, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return decodeError(resp)
+	}
+	return nil
+}
+
+// UnfollowPlaylist removes the current user as a follower of a playlist.
+// This call requires authorization.  Unfollowing a publicly followed playlist
+// requires the ScopePlaylistModifyPublic scope.  Unfolowing a privately followed,
+// playlist requies the ScopePlaylistModifyPrivate scope.
+func (c *Client) UnfollowPlaylist(owner, playlist ID) error {
+	return c.UnfollowPlaylistWithContext(context.Background(), owner, playlist)
+}
+
+// UnfollowPlaylistWithContext is like UnfollowPlaylist, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) UnfollowPlaylistWithContext(ctx context.Context, owner, playlist ID) error {
+	spotifyURL := buildFollowURI(owner, playlist)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", spotifyURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return decodeError(resp)
+	}
+	return nil
+}
+
+func buildFollowURI(owner, playlist ID) string {
+	return fmt.Sprintf("%susers/%s/playlists/%s/followers",
+		baseAddress, string(owner), string(playlist))
+}
+
+// GetPlaylistsForUser gets a list of the playlists owned or followed by a
+// particular Spotify user.  This call requires authorization.
+//
+// Private playlists and collaborative playlists are only retrievable for the
+// current user.  In order to read private playlists, the user must have granted
+// the ScopePlaylistReadPrivate scope.  Note that this scope alone will not
+// return collaborative playlists, even though they are always private.  In
+// order to read collaborative playlists, the user must have granted the
+// ScopePlaylistReadCollaborative scope.
+func (c *Client) GetPlaylistsForUser(userID string) (*SimplePlaylistPage, error) {
+	return c.GetPlaylistsForUserOpt(userID, nil)
+}
+
+// GetPlaylistsForUserWithContext is like GetPlaylistsForUser, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) GetPlaylistsForUserWithContext(ctx context.Context, userID string) (*SimplePlaylistPage, error) {
+	return c.GetPlaylistsForUserOptWithContext(ctx, userID, nil)
+}
+
+// GetPlaylistsForUserOpt is like GetPlaylistsForUser, but it accepts optional paramters
+// for filtering the results.
+func (c *Client) GetPlaylistsForUserOpt(userID string, opt *Options) (*SimplePlaylistPage, error) {
+	return c.GetPlaylistsForUserOptWithContext(context.Background(), userID, opt)
+}
+
+// GetPlaylistsForUserOptWithContext is like GetPlaylistsForUserOpt, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) GetPlaylistsForUserOptWithContext(ctx context.Context, userID string, opt *Options) (*SimplePlaylistPage, error) {
+	spotifyURL := baseAddress + "users/" + userID + "/playlists"
+	if opt != nil {
+		v := url.Values{}
+		if opt.Limit != nil {
+			v.Set("limit", strconv.Itoa(*opt.Limit))
+		}
+		if opt.Offset != nil {
+			v.Set("offset", strconv.Itoa(*opt.Offset))
+		}
+		if params := v.Encode(); params != "" {
+			spotifyURL += "?" + params
+		}
+	}
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var result SimplePlaylistPage
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	return &result, err
+}
+
+// GetPlaylist gets a playlist owned by a Spotify user. This call requires
+// authorization.  Both public and private playlists belonging to any user
+// are retrievable with a valid access token.
+func (c *Client) GetPlaylist(userID string, playlistID ID) (*FullPlaylist, error) {
+	return c.GetPlaylistOpt(userID, playlistID, "")
+}
+
+// GetPlaylistWithContext is like GetPlaylist, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) GetPlaylistWithContext(ctx context.Context, userID string, playlistID ID) (*FullPlaylist, error) {
+	return c.GetPlaylistOptWithContext(ctx, userID, playlistID, "")
+}
+
+// GetPlaylistOpt is like GetPlaylist, but it accepts an optional fields parameter
+// that can be used to filter the query.
+//
+// fields is a comma-separated list of the fields to return.
+// See the JSON tags on the FullPlaylist struct for valid field options.
+// For example, to get just the playlist's description and URI:
+//    fields = "description,uri"
+//
+// A dot separator can be used to specify non-reoccurring fields, while
+// parentheses can be used to specify reoccurring fields within objects.
+// For example, to get just the added date and the user ID of the adder:
+//    fields = "tracks.items(added_at,added_by.id)"
+//
+// Use multiple parentheses to drill down into nested objects, for example:
+//    fields = "tracks.items(track(name,href,album(name,href)))"
+//
+// Fields can be excluded by prefixing them with an exclamation mark, for example;
+//    fields = "tracks.items(track(name,href,album(!name,href)))"
+func (c *Client) GetPlaylistOpt(userID string, playlistID ID, fields string) (*FullPlaylist, error) {
+	return c.GetPlaylistOptWithContext(context.Background(), userID, playlistID, fields)
+}
+
+// GetPlaylistOptWithContext is like GetPlaylistOpt, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) GetPlaylistOptWithContext(ctx context.Context, userID string, playlistID ID, fields string) (*FullPlaylist, error) {
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s", baseAddress, userID, playlistID)
+	if fields != "" {
+		spotifyURL += "?fields=" + url.QueryEscape(fields)
+	}
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var playlist FullPlaylist
+	err = json.NewDecoder(resp.Body).Decode(&playlist)
+	return &playlist, err
+}
+
+// GetPlaylistTracks gets full details of the tracks in a playlist, given the
+// owner of the playlist and the playlist's Spotify ID.
+// This call requires authorization.
+func (c *Client) GetPlaylistTracks(userID string, playlistID ID) (*PlaylistTrackPage, error) {
+	return c.GetPlaylistTracksOpt(userID, playlistID, nil, "")
+}
+
+// GetPlaylistTracksWithContext is like GetPlaylistTracks, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) GetPlaylistTracksWithContext(ctx context.Context, userID string, playlistID ID) (*PlaylistTrackPage, error) {
+	return c.GetPlaylistTracksOptWithContext(ctx, userID, playlistID, nil, "")
+}
+
+// GetPlaylistTracksOpt is like GetPlaylistTracks, but it accepts optional parameters
+// for sorting and filtering the results.  This call requrles authorization.
+//
+// The field parameter is a comma-separated list of the fields to return.  See the
+// JSON struct tags for the PlaylistTrackPage type for valid field names.
+// For example, to get just the total number of tracks and the request limit:
+//     fields = "total,limit"
+//
+// A dot separator can be used to specify non-reoccurring fields, while parentheses
+// can be used to specify reoccurring fields within objects.  For example, to get
+// just the added date and user ID of the adder:
+//     fields = "items(added_at,added_by.id)"
+//
+// Use multiple parentheses to drill down into nested objects.  For example:
+//     fields = "items(track(name,href,album(name,href)))"
+//
+// Fields can be excluded by prefixing them with an exclamation mark.  For example:
+//     fields = "items.track.album(!external_urls,images)"
+func (c *Client) GetPlaylistTracksOpt(userID string, playlistID ID,
+	opt *Options, fields string) (*PlaylistTrackPage, error) {
+
+	return c.GetPlaylistTracksOptWithContext(context.Background(), userID, playlistID, opt, fields)
+}
+
+// GetPlaylistTracksOptWithContext is like GetPlaylistTracksOpt, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) GetPlaylistTracksOptWithContext(ctx context.Context, userID string, playlistID ID,
+	opt *Options, fields string) (*PlaylistTrackPage, error) {
+
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s/tracks", baseAddress, userID, playlistID)
+	v := url.Values{}
+	if fields != "" {
+		v.Set("fields", fields)
+	}
+	if opt != nil {
+		if opt.Limit != nil {
+			v.Set("limit", strconv.Itoa(*opt.Limit))
+		}
+		if opt.Offset != nil {
+			v.Set("offset", strconv.Itoa(*opt.Offset))
+		}
+	}
+	if params := v.Encode(); params != "" {
+		spotifyURL += "?" + params
+	}
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var result PlaylistTrackPage
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	return &result, err
+}
+
+// CreatePlaylistForUser creates a playlist for a Spotify user.
+// The playlist will be empty until you add tracks to it.
+// The playlistName does not need to be unique - a user can have
+// several playlists with the same name.
+//
+// This call requires authorization.  Creating a public playlist
+// for a user requires the ScopePlaylistModifyPublic scope;
+// creating a private playlist requires the ScopePlaylistModifyPrivate
+// scope.
+//
+// On success, the newly created playlist is returned.
+func (c *Client) CreatePlaylistForUser(userID, playlistName string, public bool) (*FullPlaylist, error) {
+	return c.CreatePlaylistForUserWithContext(context.Background(), userID, playlistName, public)
+}
+
+// CreatePlaylistForUserWithContext is like CreatePlaylistForUser, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) CreatePlaylistForUserWithContext(ctx context.Context, userID, playlistName string, public bool) (*FullPlaylist, error) {
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists", baseAddress, userID)
+	body := struct {
+		Name   string `json:"name"`
+		Public bool   `json:"public"`
+	}{
+		playlistName,
+		public,
+	}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", spotifyURL, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, decodeError(resp)
+	}
+	var p FullPlaylist
+	err = json.NewDecoder(resp.Body).Decode(&p)
+	return &p, err
+}
+
+// ChangePlaylistName changes the name of a playlist.  This call requires that the
+// user has authorized the ScopePlaylistModifyPublic or ScopePlaylistModifyPrivate
+// scopes (depending on whether the playlist is public or private).
+// The current user must own the playlist in order to modify it.
+func (c *Client) ChangePlaylistName(userID string, playlistID ID, newName string) error {
+	return c.modifyPlaylist(context.Background(), userID, playlistID, newName, nil)
+}
+
+// ChangePlaylistNameWithContext is like ChangePlaylistName, but it accepts
+// a context.Context that can be used to cancel or time out the request.
+func (c *Client) ChangePlaylistNameWithContext(ctx context.Context, userID string, playlistID ID, newName string) error {
+	return c.modifyPlaylist(ctx, userID, playlistID, newName, nil)
+}
+
+// ChangePlaylistAccess modifies the public/private status of a playlist.  This call
+// requires that the user has authorized the ScopePlaylistModifyPublic or
+// ScopePlaylistModifyPrivate scopes (depending on whether the playlist is
+// currently public or private).  The current user must own the playlist in order to modify it.
+func (c *Client) ChangePlaylistAccess(userID string, playlistID ID, public bool) error {
+	return c.modifyPlaylist(context.Background(), userID, playlistID, "", &public)
+}
+
+// ChangePlaylistAccessWithContext is like ChangePlaylistAccess, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) ChangePlaylistAccessWithContext(ctx context.Context, userID string, playlistID ID, public bool) error {
+	return c.modifyPlaylist(ctx, userID, playlistID, "", &public)
+}
+
+// ChangePlaylistNameAndAccess combines ChangePlaylistName and ChangePlaylistAccess into
+// a single Web API call.  It requires that the user has authorized the ScopePlaylistModifyPublic
+// or ScopePlaylistModifyPrivate scopes (depending on whether the playlist is currently
+// public or private).  The current user must own the playlist in order to modify it.
+func (c *Client) ChangePlaylistNameAndAccess(userID string, playlistID ID, newName string, public bool) error {
+	return c.modifyPlaylist(context.Background(), userID, playlistID, newName, &public)
+}
+
+// ChangePlaylistNameAndAccessWithContext is like
+// ChangePlaylistNameAndAccess, but it accepts a context.Context that can be
+// used to cancel or time out the request.
+func (c *Client) ChangePlaylistNameAndAccessWithContext(ctx context.Context, userID string, playlistID ID, newName string, public bool) error {
+	return c.modifyPlaylist(ctx, userID, playlistID, newName, &public)
+}
+
+func (c *Client) modifyPlaylist(ctx context.Context, userID string, playlistID ID, newName string, public *bool) error {
+	body := struct {
+		Name   string `json:"name,omitempty"`
+		Public *bool  `json:"public,omitempty"`
+	}{
+		newName,
+		public,
+	}
+	bodyJSON, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s", baseAddress, userID, string(playlistID))
+	req, err := http.NewRequestWithContext(ctx, "PUT", spotifyURL, bytes.NewReader(bodyJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return decodeError(resp)
+	}
+	return nil
+}
+
+// AddTracksToPlaylist adds one or more tracks to a user's playlist.  This call
+// requires authorization (ScopePlaylistModifyPublic or ScopePlaylistModifyPrivate).
+// A maximum of 100 tracks can be added per call.  It returns a snapshot ID that
+// can be used to identify this version (the new version) of the playlist in
+// future requests.
+func (c *Client) AddTracksToPlaylist(userID string, playlistID ID,
+	trackIDs ...ID) (snapshotID string, err error) {
+
+	return c.AddTracksToPlaylistWithContext(context.Background(), userID, playlistID, trackIDs...)
+}
+
+// AddTracksToPlaylistWithContext is like AddTracksToPlaylist, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) AddTracksToPlaylistWithContext(ctx context.Context, userID string, playlistID ID,
+	trackIDs ...ID) (snapshotID string, err error) {
+
+	uris := make([]string, len(trackIDs))
+	for i, id := range trackIDs {
+		uris[i] = fmt.Sprintf("spotify:track:%s", id)
+	}
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s/tracks?uris=%s",
+		baseAddress, userID, string(playlistID), strings.Join(uris, ","))
+	req, err := http.NewRequestWithContext(ctx, "POST", spotifyURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return "", decodeError(resp)
+	}
+	body := struct {
+		SnapshotID string `json:"snapshot_id"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	if err != nil {
+		// the response code indicates success..
+		return "", err
+	}
+	return body.SnapshotID, nil
+}
+
+// RemoveTracksFromPlaylist removes one or more tracks from a user's playlist.
+// This call requrles that the user has authorized the ScopePlaylistModifyPublic
+// or ScopePlaylistModifyPrivate scopes.
+//
+// If the track(s) occur multiple times in the specified playlist, then all occurrences
+// of the track will be removed.  If successful, the snapshot ID returned can be used to
+// identify the playlist version in future requests.
+func (c *Client) RemoveTracksFromPlaylist(userID string, playlistID ID,
+	trackIDs ...ID) (newSnapshotID string, err error) {
+
+	return c.RemoveTracksFromPlaylistWithContext(context.Background(), userID, playlistID, trackIDs...)
+}
+
+// RemoveTracksFromPlaylistWithContext is like RemoveTracksFromPlaylist, but
+// it accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) RemoveTracksFromPlaylistWithContext(ctx context.Context, userID string, playlistID ID,
+	trackIDs ...ID) (newSnapshotID string, err error) {
+
+	tracks := make([]struct {
+		URI string `json:"uri"`
+	}, len(trackIDs))
+
+	for i, u := range trackIDs {
+		tracks[i].URI = fmt.Sprintf("spotify:track:%s", u)
+	}
+	return c.removeTracksFromPlaylist(ctx, userID, playlistID, tracks, "")
+}
+
+// TrackToRemove specifies a track to be removed from a playlist.
+// Positions is a slice of 0-based track indices.
+// TrackToRemove is used with RemoveTracksFromPlaylistOpt.
+type TrackToRemove struct {
+	URI       string `json:"uri"`
+	Positions []int  `json:"positions"`
+}
+
+// NewTrackToRemove creates a new TrackToRemove object with the specified
+// track ID and playlist locations.
+func NewTrackToRemove(trackID string, positions []int) TrackToRemove {
+	return TrackToRemove{
+		URI:       fmt.Sprintf("spotify:track:%s", trackID),
+		Positions: positions,
+	}
+}
+
+// RemoveTracksFromPlaylistOpt is like RemoveTracksFromPlaylist, but it supports
+// optional parameters that offer more fine-grained control.  Instead of deleting
+// all occurrences of a track, this function takes an index with each track URI
+// that indicates the position of the track in the playlist.
+//
+// In addition, the snapshotID parameter allows you to specify the snapshot ID
+// against which you want to make the changes.  Spotify will validate that the
+// specified tracks exist in the specified positions and make the changes, even
+// if more recent changes have been made to the playlist.  If a track in the
+// specified position is not found, the entire request will fail and no edits
+// will take place. (Note: the snapshot is optional, pass the empty string if
+// you don't care about it.)
+func (c *Client) RemoveTracksFromPlaylistOpt(userID string, playlistID ID,
+	tracks []TrackToRemove, snapshotID string) (newSnapshotID string, err error) {
+
+	return c.removeTracksFromPlaylist(context.Background(), userID, playlistID, tracks, snapshotID)
+}
+
+// RemoveTracksFromPlaylistOptWithContext is like
+// RemoveTracksFromPlaylistOpt, but it accepts a context.Context that can be
+// used to cancel or time out the request.
+func (c *Client) RemoveTracksFromPlaylistOptWithContext(ctx context.Context, userID string, playlistID ID,
+	tracks []TrackToRemove, snapshotID string) (newSnapshotID string, err error) {
+
+	return c.removeTracksFromPlaylist(ctx, userID, playlistID, tracks, snapshotID)
+}
+
+func (c *Client) removeTracksFromPlaylist(ctx context.Context, userID string, playlistID ID,
+	tracks interface{}, snapshotID string) (newSnapshotID string, err error) {
+
+	m := make(map[string]interface{})
+	m["tracks"] = tracks
+	if snapshotID != "" {
+		m["snapshot_id"] = snapshotID
+	}
+
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s/tracks",
+		baseAddress, userID, string(playlistID))
+	body, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, "DELETE", spotifyURL, bytes.NewReader(body))
+	if err != nil {
+		return "", nil
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", decodeError(resp)
+	}
+	result := struct {
+		SnapshotID string `json:"snapshot_id"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	return result.SnapshotID, err
+}
+
+// ReplacePlaylistTracks replaces all of the tracks in a playlist, overwriting its
+// exising tracks  This can be useful for replacing or reordering tracks, or for
+// clearing a playlist.  This call requires authorization.
+//
+// Modifying a public playlist requires that the user has authorized the
+// ScopePlaylistModifyPublic scope.  Modifying a private playlist requires the
+// ScopePlaylistModifyPrivate scope.
+//
+// A maximum of 100 tracks is permited in this call.  Additional tracks must be
+// added via AddTracksToPlaylist.
+func (c *Client) ReplacePlaylistTracks(userID string, playlistID ID, trackIDs ...ID) error {
+	return c.ReplacePlaylistTracksWithContext(context.Background(), userID, playlistID, trackIDs...)
+}
+
+// ReplacePlaylistTracksWithContext is like ReplacePlaylistTracks, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) ReplacePlaylistTracksWithContext(ctx context.Context, userID string, playlistID ID, trackIDs ...ID) error {
+	trackURIs := make([]string, len(trackIDs))
+	for i, u := range trackIDs {
+		trackURIs[i] = fmt.Sprintf("spotify:track:%s", u)
+	}
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s/tracks?uris=%s",
+		baseAddress, userID, playlistID, strings.Join(trackURIs, ","))
+	req, err := http.NewRequestWithContext(ctx, "PUT", spotifyURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return decodeError(resp)
+	}
+	return nil
+}
+
+// UserFollowsPlaylist checks if one or more (up to 5) Spotify users are following
+// a Spotify playlist, given the playlist's owner and ID.  This call requires
+// authorization.
+//
+// Checking if a user follows a playlist publicly doesn't require any scopes.
+// Checking if the user is privately following a playlist is only possible for the
+// current user when that user has granted access to the ScopePlaylistReadPrivate scope.
+func (c *Client) UserFollowsPlaylist(ownerID string, playlistID ID, userIDs ...string) ([]bool, error) {
+	return c.UserFollowsPlaylistWithContext(context.Background(), ownerID, playlistID, userIDs...)
+}
+
+// UserFollowsPlaylistWithContext is like UserFollowsPlaylist, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) UserFollowsPlaylistWithContext(ctx context.Context, ownerID string, playlistID ID, userIDs ...string) ([]bool, error) {
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s/followers/contains?ids=%s",
+		baseAddress, ownerID, playlistID, strings.Join(userIDs, ","))
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	follows := make([]bool, len(userIDs))
+	err = json.NewDecoder(resp.Body).Decode(&follows)
+	return follows, err
+}
+
+// PlaylistReorderOptions is used with ReorderPlaylistTracks to reorder
+// a track or group of tracks in a playlist.
+//
+// For example, in a playlist with 10 tracks, you can:
+//
+// - move the first track to the end of the playlist by setting
+//   RangeStart to 0 and InsertBefore to 10
+// - move the last track to the beginning of the playlist by setting
+//   RangeStart to 9 and InsertBefore to 0
+// - Move the last 2 tracks to the beginning of the playlist by setting
+//   RangeStart to 8 and RangeLength to 2.
+type PlaylistReorderOptions struct {
+	// The position of the first track to be reordered.
+	// This field is required.
+	RangeStart int `json:"range_start"`
+	// The amount of tracks to be reordered.  This field is optional.  If
+	// you don't set it, the value 1 will be used.
+	RangeLength int `json:"range_length,omitempty"`
+	// The position where the tracks should be inserted.  To reorder the
+	// tracks to the end of the playlist, simply set this to the position
+	// after the last track.  This field is required.
+	InsertBefore int `json:"insert_before"`
+	// The playlist's snapshot ID against which you wish to make the changes.
+	// This field is optional.
+	SnapshotID string `json:"snapshot_id,omitempty"`
+}
+
+// ReorderPlaylistTracks reorders a track or group of tracks in a playlist.  It
+// returns a snapshot ID that can be used to identify the [newly modified] playlist
+// version in future requests.
+//
+// See the docs for PlaylistReorderOptions for information on how the reordering
+// works.
+//
+// This call requires authorization.  Rordering tracks in the current user's
+// public playlist requires ScopePlaylistModifyPublic.  Reordering tracks in
+// the user's private playlists (including collaborative playlists) requires
+// ScopePlaylistModifyPrivate.
+func (c *Client) ReorderPlaylistTracks(userID, playlistID ID, opt PlaylistReorderOptions) (snapshotID string, err error) {
+	return c.ReorderPlaylistTracksWithContext(context.Background(), userID, playlistID, opt)
+}
+
+// ReorderPlaylistTracksWithContext is like ReorderPlaylistTracks, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) ReorderPlaylistTracksWithContext(ctx context.Context, userID, playlistID ID, opt PlaylistReorderOptions) (snapshotID string, err error) {
+	spotifyURL := fmt.Sprintf("%susers/%s/playlists/%s/tracks", baseAddress, userID, playlistID)
+	j, err := json.Marshal(opt)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, "PUT", spotifyURL, bytes.NewReader(j))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", decodeError(resp)
+	}
+	result := struct {
+		SnapshotID string `json:"snapshot_id"`
+	}{}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	return result.SnapshotID, err
+}
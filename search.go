@@ -0,0 +1,393 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MarketFromToken can be used in place of the Market parameter
+	// if the Client has a valid access token.  In this case, the
+	// results will be limited to content that is playable in the
+	// country associated with the user's account.  The user must have
+	// granted access to the user-read-private scope when the access
+	// token was issued.
+	MarketFromToken = "from_token"
+)
+
+// SearchType represents the type of a query used in the Search function.
+type SearchType int
+
+// Search type values that can be passed to the Search function.  These are flags
+// that can be bitwise OR'd together to search for multiple types of content simultaneously.
+const (
+	SearchTypeAlbum SearchType = 1 << iota
+	SearchTypeArtist
+	SearchTypePlaylist
+	SearchTypeTrack
+)
+
+func (st SearchType) encode() string {
+	types := []string{}
+	if st&SearchTypeAlbum != 0 {
+		types = append(types, "album")
+	}
+	if st&SearchTypeArtist != 0 {
+		types = append(types, "artist")
+	}
+	if st&SearchTypePlaylist != 0 {
+		types = append(types, "playlist")
+	}
+	if st&SearchTypeTrack != 0 {
+		types = append(types, "track")
+	}
+	return strings.Join(types, ",")
+}
+
+// SearchResult contains the results of a call to Search.
+// Fields that weren't searched for will be nil pointers.
+type SearchResult struct {
+	Artists   *FullArtistPage     `json:"artists"`
+	Albums    *SimpleAlbumPage    `json:"albums"`
+	Playlists *SimplePlaylistPage `json:"playlists"`
+	Tracks    *FullTrackPage      `json:"tracks"`
+}
+
+// Search is a wrapper around DefaultClient.Search.
+func Search(query string, t SearchType) (*SearchResult, error) {
+	return DefaultClient.Search(query, t)
+}
+
+// SearchOpt is a wrapper around DefaultClient.SearchOpt
+func SearchOpt(query string, t SearchType, opt *Options) (*SearchResult, error) {
+	return DefaultClient.SearchOpt(query, t, opt)
+}
+
+// Search gets Spotify catalog information about artists, albums, tracks,
+// or playlists that match a keyword string.  t is a mask containing one or more
+// search types.  For example, `Search(query, SearchTypeArtist|SearchTypeAlbum)`
+// will search for artists or albums matching the specified keywords.
+//
+// Matching
+//
+// Matching of search keywords is NOT case sensitive.  Keywords are matched in
+// any order unless surrounded by double quotes. Searching for playlists will
+// return results where the query keyword(s) match any part of the playlist's
+// name or description. Only popular public playlists are returned.
+//
+// Operators
+//
+// The operator NOT can be used to exclude results.  For example,
+// query = "roadhouse NOT blues" returns items that match "roadhouse" but exludes
+// those that also contain the keyword "blues".  Similarly, the OR operator can
+// be used to broaden the search.  query = "roadhouse OR blues" returns all results
+// that include either of the terms.  Only one OR operator can be used in a query.
+//
+// Operators should be specified in uppercase.
+//
+// Wildcards
+//
+// The asterisk (*) character can, with some limitations, be used as a wildcard
+// (maximum of 2 per query).  It will match a variable number of non-white-space
+// characters.  It cannot be used in a quoted phrase, in a field filter, or as
+// the first character of a keyword string.
+//
+// Field filters
+//
+// By default, results are returned when a match is found in any field of the
+// target object type.  Searches can be made more specific by specifying an album,
+// artist, or track field filter.  For example, "album:gold artist:abba type:album"
+// will only return results with the text "gold" in the album name and the text
+// "abba" in the artist's name.
+//
+// The field filter "year" can be used with album, artist, and track searches to
+// limit the results to a particular year. For example "bob year:2014" or
+// "bob year:1980-2020".
+//
+// The field filter "tag:new" can be used in album searches to retrieve only
+// albums released in the last two weeks. The field filter "tag:hipster" can be
+// used in album searches to retrieve only albums with the lowest 10% popularity.
+//
+// Other possible field filters, depending on object types being searched,
+// include "genre", "upc", and "isrc".  For example "damian genre:reggae-pop".
+func (c *Client) Search(query string, t SearchType) (*SearchResult, error) {
+	return c.SearchOpt(query, t, nil)
+}
+
+// SearchWithContext is like Search, but it accepts a context.Context that
+// can be used to cancel or time out the request.
+func (c *Client) SearchWithContext(ctx context.Context, query string, t SearchType) (*SearchResult, error) {
+	return c.SearchOptWithContext(ctx, query, t, nil)
+}
+
+// SearchOpt works just like Search, but it accepts additional
+// parameters for filtering the output.  See the documentation for Search more
+// more information.
+//
+// If the Country field is specified in the options, then the results will only
+// contain artists, albums, and tracks playable in the specified country
+// (playlist results are not affected by the Country option).  Additionally,
+// the constant MarketFromToken can be used with authenticated clients.
+// If the client has a valid access token, then the results will only include
+// content playable in the user's country.
+func (c *Client) SearchOpt(query string, t SearchType, opt *Options) (*SearchResult, error) {
+	return c.SearchOptWithContext(context.Background(), query, t, opt)
+}
+
+// SearchOptWithContext is like SearchOpt, but it accepts a context.Context
+// that can be used to cancel or time out the request.
+func (c *Client) SearchOptWithContext(ctx context.Context, query string, t SearchType, opt *Options) (*SearchResult, error) {
+	query = url.QueryEscape(query)
+	v := url.Values{}
+	v.Set("q", query)
+	v.Set("type", t.encode())
+	if opt != nil {
+		if opt.Limit != nil {
+			v.Set("limit", strconv.Itoa(*opt.Limit))
+		}
+		if opt.Country != nil {
+			v.Set("market", *opt.Country)
+		}
+		if opt.Offset != nil {
+			v.Set("offset", strconv.Itoa(*opt.Offset))
+		}
+	}
+	spotifyURL := baseAddress + "search?" + v.Encode()
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+
+	var result SearchResult
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, err
+}
+
+// SearchArtists is a wrapper around DefaultClient.SearchArtists.
+func SearchArtists(ctx context.Context, name string, limit int) ([]FullArtist, error) {
+	return DefaultClient.SearchArtists(ctx, name, limit)
+}
+
+// SearchArtists searches the Spotify catalog for artists matching name,
+// returning up to limit results.  It is a convenience for the common case
+// of an artist-only lookup by name; it builds its own request rather than
+// calling SearchOpt, and a limit of 0 or less leaves the limit unset.
+func (c *Client) SearchArtists(ctx context.Context, name string, limit int) ([]FullArtist, error) {
+	v := url.Values{}
+	v.Set("q", name)
+	v.Set("type", SearchTypeArtist.encode())
+	if limit > 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+	spotifyURL := baseAddress + "search?" + v.Encode()
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var result SearchResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if result.Artists == nil {
+		return nil, nil
+	}
+	return result.Artists.Artists, nil
+}
+
+// SearchArtistImages is a wrapper around DefaultClient.SearchArtistImages.
+func SearchArtistImages(ctx context.Context, name string, limit int) ([]Image, error) {
+	return DefaultClient.SearchArtistImages(ctx, name, limit)
+}
+
+// SearchArtistImages searches the Spotify catalog for artists matching name
+// and returns the Images of the best match, sorted by width descending.
+//
+// The best match is the first artist whose name is an exact, case-insensitive
+// match for name.  If there is no exact match, the artist whose name is most
+// similar to name by Jaro-Winkler similarity is used instead.
+// SearchArtistImages returns a nil slice, with no error, if the search
+// returns no artists.
+func (c *Client) SearchArtistImages(ctx context.Context, name string, limit int) ([]Image, error) {
+	artists, err := c.SearchArtists(ctx, name, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(artists) == 0 {
+		return nil, nil
+	}
+	best := bestArtistMatch(artists, name)
+	images := append([]Image(nil), best.Images...)
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Width > images[j].Width
+	})
+	return images, nil
+}
+
+// bestArtistMatch returns the artist in artists whose name is the best
+// match for name: an exact, case-insensitive match if one exists, and
+// otherwise the artist with the highest Jaro-Winkler similarity.
+// artists must be non-empty.
+func bestArtistMatch(artists []FullArtist, name string) FullArtist {
+	for _, a := range artists {
+		if strings.EqualFold(a.Name, name) {
+			return a
+		}
+	}
+	lowerName := strings.ToLower(name)
+	best := artists[0]
+	bestScore := jaroWinkler(strings.ToLower(best.Name), lowerName)
+	for _, a := range artists[1:] {
+		if score := jaroWinkler(strings.ToLower(a.Name), lowerName); score > bestScore {
+			best, bestScore = a, score
+		}
+	}
+	return best
+}
+
+// NextArtistResults loads the next page of artists into the specified search result.
+func (c *Client) NextArtistResults(s *SearchResult) error {
+	return c.NextArtistResultsWithContext(context.Background(), s)
+}
+
+// NextArtistResultsWithContext is like NextArtistResults, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) NextArtistResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Artists == nil || s.Artists.Next == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Artists.Next, s)
+}
+
+// PreviousArtistResults loads the previous page of artists into the specified search result.
+func (c *Client) PreviousArtistResults(s *SearchResult) error {
+	return c.PreviousArtistResultsWithContext(context.Background(), s)
+}
+
+// PreviousArtistResultsWithContext is like PreviousArtistResults, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) PreviousArtistResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Artists == nil || s.Artists.Previous == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Artists.Previous, s)
+}
+
+// NextAlbumResults loads the next page of albums into the specified search result.
+func (c *Client) NextAlbumResults(s *SearchResult) error {
+	return c.NextAlbumResultsWithContext(context.Background(), s)
+}
+
+// NextAlbumResultsWithContext is like NextAlbumResults, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) NextAlbumResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Albums == nil || s.Albums.Next == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Albums.Next, s)
+}
+
+// PreviousAlbumResults loads the previous page of albums into the specified search result.
+func (c *Client) PreviousAlbumResults(s *SearchResult) error {
+	return c.PreviousAlbumResultsWithContext(context.Background(), s)
+}
+
+// PreviousAlbumResultsWithContext is like PreviousAlbumResults, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) PreviousAlbumResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Albums == nil || s.Albums.Previous == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Albums.Previous, s)
+}
+
+// NextPlaylistResults loads the next page of playlists into the specified search result.
+func (c *Client) NextPlaylistResults(s *SearchResult) error {
+	return c.NextPlaylistResultsWithContext(context.Background(), s)
+}
+
+// NextPlaylistResultsWithContext is like NextPlaylistResults, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) NextPlaylistResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Playlists == nil || s.Playlists.Next == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Playlists.Next, s)
+}
+
+// PreviousPlaylistResults loads the previous page of playlists into the specified search result.
+func (c *Client) PreviousPlaylistResults(s *SearchResult) error {
+	return c.PreviousPlaylistResultsWithContext(context.Background(), s)
+}
+
+// PreviousPlaylistResultsWithContext is like PreviousPlaylistResults, but
+// it accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) PreviousPlaylistResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Playlists == nil || s.Playlists.Previous == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Playlists.Previous, s)
+}
+
+// PreviousTrackResults loads the previous page of tracks into the specified search result.
+func (c *Client) PreviousTrackResults(s *SearchResult) error {
+	return c.PreviousTrackResultsWithContext(context.Background(), s)
+}
+
+// PreviousTrackResultsWithContext is like PreviousTrackResults, but it
+// accepts a context.Context that can be used to cancel or time out the
+// request.
+func (c *Client) PreviousTrackResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Tracks == nil || s.Tracks.Previous == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Tracks.Previous, s)
+}
+
+// NextTrackResults loads the next page of tracks into the specified search result.
+func (c *Client) NextTrackResults(s *SearchResult) error {
+	return c.NextTrackResultsWithContext(context.Background(), s)
+}
+
+// NextTrackResultsWithContext is like NextTrackResults, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) NextTrackResultsWithContext(ctx context.Context, s *SearchResult) error {
+	if s.Tracks == nil || s.Tracks.Next == "" {
+		return ErrNoMorePages
+	}
+	return c.getPageContext(ctx, s.Tracks.Next, s)
+}
@@ -0,0 +1,276 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+)
+
+func TestSearchArtist(t *testing.T) {
+	client := testClientFile(http.StatusOK, "test_data/search_artist.txt")
+	result, err := client.Search("tania bowra", SearchTypeArtist)
+	if err != nil {
+		t.Error(err)
+	}
+	if result.Albums != nil {
+		t.Error("Searched for artists but received album results")
+	}
+	if result.Playlists != nil {
+		t.Error("Searched for artists but received playlist results")
+	}
+	if result.Tracks != nil {
+		t.Error("Searched for artists but received track results")
+	}
+	if result.Artists == nil || len(result.Artists.Artists) == 0 {
+		t.Error("Didn't receive artist results")
+	}
+	if result.Artists.Artists[0].Name != "Tania Bowra" {
+		t.Error("Got wrong artist name")
+	}
+}
+
+func TestSearchOptWithContext(t *testing.T) {
+	client := testClientFile(http.StatusOK, "test_data/search_artist.txt")
+	ctx := context.WithValue(context.Background(), contextKey("request-id"), "abc123")
+	_, err := client.SearchOptWithContext(ctx, "tania bowra", SearchTypeArtist, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := getLastRequest(client)
+	if got := req.Context().Value(contextKey("request-id")); got != "abc123" {
+		t.Errorf("Expected the request's context to carry the caller's ctx, got %v", got)
+	}
+}
+
+func TestSearchTracks(t *testing.T) {
+	client := testClientFile(http.StatusOK, "test_data/search_tracks.txt")
+	result, err := client.Search("uptown", SearchTypeTrack)
+	if err != nil {
+		t.Error(err)
+	}
+	if result.Albums != nil {
+		t.Error("Searched for tracks but got album results")
+	}
+	if result.Playlists != nil {
+		t.Error("Searched for tracks but got playlist results")
+	}
+	if result.Artists != nil {
+		t.Error("Searched for tracks but got artist results")
+	}
+	if result.Tracks == nil || len(result.Tracks.Tracks) == 0 {
+		t.Fatal("Didn't receive track results")
+	}
+	if name := result.Tracks.Tracks[0].Name; name != "Uptown Funk" {
+		t.Errorf("Got %s, wanted Uptown Funk\n", name)
+	}
+}
+
+func TestSearchPlaylistTrack(t *testing.T) {
+	client := testClientFile(http.StatusOK, "test_data/search_trackplaylist.txt")
+	result, err := client.Search("holiday", SearchTypePlaylist|SearchTypeTrack)
+	if err != nil {
+		t.Error(err)
+	}
+	if result.Albums != nil {
+		t.Error("Searched for playlists and tracks but received album results")
+	}
+	if result.Artists != nil {
+		t.Error("Searched for playlists and tracks but received artist results")
+	}
+	if result.Tracks == nil {
+		t.Error("Didn't receive track results")
+	}
+	if result.Playlists == nil {
+		t.Error("Didn't receive playlist results")
+	}
+}
+
+func TestNextArtistResultsWithContext(t *testing.T) {
+	client := testClientString(http.StatusOK, searchArtistsResponse)
+	results := &SearchResult{Artists: &FullArtistPage{basePage: basePage{Next: "https://api.spotify.com/v1/search?query=daft+punk&offset=20&limit=20&type=artist"}}}
+	ctx := context.WithValue(context.Background(), contextKey("request-id"), "abc123")
+	if err := client.NextArtistResultsWithContext(ctx, results); err != nil {
+		t.Fatal(err)
+	}
+	req := getLastRequest(client)
+	if got := req.Context().Value(contextKey("request-id")); got != "abc123" {
+		t.Errorf("Expected the request's context to carry the caller's ctx, got %v", got)
+	}
+}
+
+func TestPrevNextSearchPageErrors(t *testing.T) {
+	// we expect to get ErrNoMorePages when trying to get the prev/next page
+	// under either of these conditions:
+
+	//  1) there are no results (nil)
+	nilResults := &SearchResult{nil, nil, nil, nil}
+	if DefaultClient.NextAlbumResults(nilResults) != ErrNoMorePages ||
+		DefaultClient.NextArtistResults(nilResults) != ErrNoMorePages ||
+		DefaultClient.NextPlaylistResults(nilResults) != ErrNoMorePages ||
+		DefaultClient.NextTrackResults(nilResults) != ErrNoMorePages {
+		t.Error("Next search result page should have failed for nil results")
+	}
+	if DefaultClient.PreviousAlbumResults(nilResults) != ErrNoMorePages ||
+		DefaultClient.PreviousArtistResults(nilResults) != ErrNoMorePages ||
+		DefaultClient.PreviousPlaylistResults(nilResults) != ErrNoMorePages ||
+		DefaultClient.PreviousTrackResults(nilResults) != ErrNoMorePages {
+		t.Error("Previous search result page should have failed for nil results")
+	}
+	//  2) the prev/next URL is empty
+	emptyURL := &SearchResult{
+		Artists:   new(FullArtistPage),
+		Albums:    new(SimpleAlbumPage),
+		Playlists: new(SimplePlaylistPage),
+		Tracks:    new(FullTrackPage),
+	}
+	if DefaultClient.NextAlbumResults(emptyURL) != ErrNoMorePages ||
+		DefaultClient.NextArtistResults(emptyURL) != ErrNoMorePages ||
+		DefaultClient.NextPlaylistResults(emptyURL) != ErrNoMorePages ||
+		DefaultClient.NextTrackResults(emptyURL) != ErrNoMorePages {
+		t.Error("Next search result page should have failed with empty URL")
+	}
+	if DefaultClient.PreviousAlbumResults(emptyURL) != ErrNoMorePages ||
+		DefaultClient.PreviousArtistResults(emptyURL) != ErrNoMorePages ||
+		DefaultClient.PreviousPlaylistResults(emptyURL) != ErrNoMorePages ||
+		DefaultClient.PreviousTrackResults(emptyURL) != ErrNoMorePages {
+		t.Error("Previous search result page should have failed with empty URL")
+	}
+}
+
+const searchArtistsResponse = `
+{
+  "artists" : {
+    "href" : "https://api.spotify.com/v1/search?query=daft+punk&offset=0&limit=20&type=artist",
+    "items" : [ {
+      "genres" : [ "electro" ],
+      "href" : "https://api.spotify.com/v1/artists/1",
+      "id" : "1",
+      "images" : [ {
+        "height" : 64,
+        "url" : "https://i.scdn.co/image/small",
+        "width" : 64
+      }, {
+        "height" : 640,
+        "url" : "https://i.scdn.co/image/large",
+        "width" : 640
+      } ],
+      "name" : "Daft Punk Tribute",
+      "popularity" : 10,
+      "type" : "artist",
+      "uri" : "spotify:artist:1"
+    }, {
+      "genres" : [ "house", "french touch" ],
+      "href" : "https://api.spotify.com/v1/artists/2",
+      "id" : "2",
+      "images" : [ {
+        "height" : 300,
+        "url" : "https://i.scdn.co/image/medium",
+        "width" : 300
+      } ],
+      "name" : "Daft Punk",
+      "popularity" : 90,
+      "type" : "artist",
+      "uri" : "spotify:artist:2"
+    } ],
+    "limit" : 20,
+    "next" : null,
+    "offset" : 0,
+    "previous" : null,
+    "total" : 2
+  }
+}`
+
+func TestSearchArtists(t *testing.T) {
+	client := testClientString(http.StatusOK, searchArtistsResponse)
+	artists, err := client.SearchArtists(context.Background(), "Daft Punk", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(artists) != 2 {
+		t.Fatalf("Expected 2 artists, got %d", len(artists))
+	}
+}
+
+func TestSearchArtistImagesExactMatch(t *testing.T) {
+	client := testClientString(http.StatusOK, searchArtistsResponse)
+	// "Daft Punk" is an exact match, even though "Daft Punk Tribute" sorts
+	// first in the response and is a decent fuzzy match too.
+	images, err := client.SearchArtistImages(context.Background(), "Daft Punk", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 1 {
+		t.Fatalf("Expected 1 image from the exact match, got %d", len(images))
+	}
+	if images[0].URL != "https://i.scdn.co/image/medium" {
+		t.Error("Expected images from the exact match 'Daft Punk', got", images[0].URL)
+	}
+}
+
+func TestSearchArtistImagesFuzzyMatch(t *testing.T) {
+	client := testClientString(http.StatusOK, searchArtistsResponse)
+	// no exact match exists, so the closest name by similarity should win
+	images, err := client.SearchArtistImages(context.Background(), "daft punk tribute band", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("Expected 2 images from the fuzzy match, got %d", len(images))
+	}
+	if images[0].Width < images[1].Width {
+		t.Error("Expected images sorted by width descending")
+	}
+}
+
+func TestSearchArtistImagesNoResults(t *testing.T) {
+	empty := `{ "artists" : { "href" : "", "items" : [ ], "limit" : 20, "next" : null, "offset" : 0, "previous" : null, "total" : 0 } }`
+	client := testClientString(http.StatusOK, empty)
+	images, err := client.SearchArtistImages(context.Background(), "nobody", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if images != nil {
+		t.Error("Expected a nil slice when the search returns no artists")
+	}
+}
+
+func TestSearchAgainstAPI(t *testing.T) {
+	if os.Getenv("FULLTEST") == "" {
+		t.Skip()
+		return
+	}
+	t.Parallel()
+	res, err := Search("Dave", SearchTypeArtist)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// keep requesting the next page of results, up to a maximum of 5 times
+	i := 0
+	for err = nil; err != ErrNoMorePages && i < 5; err = DefaultClient.NextArtistResults(res) {
+		i++
+	}
+	lastArtist := res.Artists.Artists[0].ID
+	// backtrack one page and make sure our artist changed
+	if err = DefaultClient.PreviousArtistResults(res); err != nil {
+		t.Error(err)
+	}
+	if lastArtist == res.Artists.Artists[0].ID {
+		t.Error("Failed to get previous page")
+	}
+}
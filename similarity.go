@@ -0,0 +1,104 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+// This file implements Jaro-Winkler string similarity, used by
+// SearchArtistImages to rank artist name matches that aren't exact.
+
+// jaroWinklerPrefixSize is the maximum length of the common prefix that
+// contributes to the Jaro-Winkler boost.
+const jaroWinklerPrefixSize = 4
+
+// jaroWinklerScalingFactor weights how much a common prefix boosts the
+// underlying Jaro score.  0.1 is the standard value.
+const jaroWinklerScalingFactor = 0.1
+
+// jaroWinkler returns the Jaro-Winkler similarity of s1 and s2, a score
+// between 0 (no similarity) and 1 (identical) that favors strings sharing
+// a common prefix.
+func jaroWinkler(s1, s2 string) float64 {
+	j := jaro(s1, s2)
+	r1, r2 := []rune(s1), []rune(s2)
+	prefix := 0
+	for prefix < jaroWinklerPrefixSize && prefix < len(r1) && prefix < len(r2) && r1[prefix] == r2[prefix] {
+		prefix++
+	}
+	return j + float64(prefix)*jaroWinklerScalingFactor*(1-j)
+}
+
+// jaro returns the Jaro similarity of s1 and s2, a score between 0 and 1.
+func jaro(s1, s2 string) float64 {
+	r1, r2 := []rune(s1), []rune(s2)
+	l1, l2 := len(r1), len(r2)
+	if l1 == 0 && l2 == 0 {
+		return 1
+	}
+	if l1 == 0 || l2 == 0 {
+		return 0
+	}
+
+	matchDist := l1
+	if l2 > matchDist {
+		matchDist = l2
+	}
+	matchDist = matchDist/2 - 1
+	if matchDist < 0 {
+		matchDist = 0
+	}
+
+	s1Matches := make([]bool, l1)
+	s2Matches := make([]bool, l2)
+	matches := 0
+	for i := range r1 {
+		start := i - matchDist
+		if start < 0 {
+			start = 0
+		}
+		end := i + matchDist + 1
+		if end > l2 {
+			end = l2
+		}
+		for j := start; j < end; j++ {
+			if s2Matches[j] || r1[i] != r2[j] {
+				continue
+			}
+			s1Matches[i] = true
+			s2Matches[j] = true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	k := 0
+	for i := range r1 {
+		if !s1Matches[i] {
+			continue
+		}
+		for !s2Matches[k] {
+			k++
+		}
+		if r1[i] != r2[k] {
+			transpositions++
+		}
+		k++
+	}
+
+	m := float64(matches)
+	return (m/float64(l1) + m/float64(l2) + (m-float64(transpositions)/2)/m) / 3
+}
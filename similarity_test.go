@@ -0,0 +1,34 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import "testing"
+
+func TestJaroWinkler(t *testing.T) {
+	if s := jaroWinkler("", ""); s != 1 {
+		t.Errorf("Expected identical empty strings to score 1, got %f", s)
+	}
+	if s := jaroWinkler("abc", ""); s != 0 {
+		t.Errorf("Expected an empty string to have no similarity, got %f", s)
+	}
+	if s := jaroWinkler("daft punk", "daft punk"); s != 1 {
+		t.Errorf("Expected identical strings to score 1, got %f", s)
+	}
+	close := jaroWinkler("daft punk", "daft punk tribute")
+	far := jaroWinkler("daft punk", "radiohead")
+	if close <= far {
+		t.Errorf("Expected a shared prefix to score higher than an unrelated string, got %f <= %f", close, far)
+	}
+}
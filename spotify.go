@@ -0,0 +1,367 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spotify provides utilties for interfacing
+// with Spotify's Web API.
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DateLayout can be used with time.Parse to create time.Time values
+	// from Spotify date strings.  For example, PrivateUser.Birthdate
+	// uses this format.
+	DateLayout = "2006-01-02"
+	// TimestampLayout can be used with time.Parse to create time.Time
+	// values from SpotifyTimestamp strings.  It is an ISO 8601 UTC timestamp
+	// with a zero offset.  For example, PlaylistTrack's AddedAt field uses
+	// this format.
+	TimestampLayout = "2006-01-02T15:04:05Z"
+)
+
+var (
+	baseAddress = "https://api.spotify.com/v1/"
+
+	// DefaultClient is the default client that is used by the wrapper functions
+	// that don't require authorization.  If you need to authenticate, create
+	// your own client with `Authenticator.NewClient`.
+	DefaultClient = &Client{
+		http: new(http.Client),
+	}
+)
+
+// URI identifies an artist, album, track, or category.  For example,
+// spotify:track:6rqhFgbbKwnb9MLmUQDhG6
+type URI string
+
+// ID is a base-62 identifier for an artist, track, album, etc.
+// It can be found at the end of a spotify.URI.
+type ID string
+
+func (id *ID) String() string {
+	return string(*id)
+}
+
+// Followers contains information about the number of people following a
+// particular artist or playlist.
+type Followers struct {
+	// The total number of followers.
+	Count uint `json:"total"`
+	// A link to the Web API endpoint providing full details of the followers,
+	// or the empty string if this data is not available.
+	Endpoint string `json:"href"`
+}
+
+// Image identifies an image associated with an item.
+type Image struct {
+	// The image height, in pixels.
+	Height int `json:"height"`
+	// The image width, in pixels.
+	Width int `json:"width"`
+	// The source URL of the image.
+	URL string `json:"url"`
+}
+
+// Download downloads the image and writes its data to the specified io.Writer.
+func (i Image) Download(dst io.Writer) error {
+	resp, err := http.Get(i.URL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// TODO: get Content-Type from header?
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("Couldn't download image - HTTP" + strconv.Itoa(resp.StatusCode))
+	}
+	_, err = io.Copy(dst, resp.Body)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Error represents an error returned by the Spotify Web API.
+type Error struct {
+	// A short description of the error.
+	Message string `json:"message"`
+	// The HTTP status code.
+	Status int `json:"status"`
+	// Reason is an additional, machine-readable error code that some
+	// endpoints include on authentication and player errors, for example
+	// "NO_ACTIVE_DEVICE" or "PREMIUM_REQUIRED".  It is empty if Spotify
+	// didn't return one.
+	Reason string `json:"reason"`
+	// RetryAfter is how long the caller should wait before retrying,
+	// parsed from the Retry-After header of an HTTP 429 response. It is
+	// zero for any other status.
+	RetryAfter time.Duration `json:"-"`
+	// URL and Method identify the request that produced the error.
+	URL    string `json:"-"`
+	Method string `json:"-"`
+	// Body holds the raw, undecoded response body.
+	Body []byte `json:"-"`
+}
+
+func (e Error) Error() string {
+	return e.Message
+}
+
+// Is lets Error be matched with errors.Is against the sentinel errors
+// ErrNotFound, ErrRateLimited, and ErrPremiumRequired.
+func (e Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Status == http.StatusNotFound
+	case ErrRateLimited:
+		return e.Status == http.StatusTooManyRequests
+	case ErrPremiumRequired:
+		return e.Reason == "PREMIUM_REQUIRED"
+	}
+	return false
+}
+
+var (
+	// ErrNotFound indicates that the requested resource doesn't exist.
+	ErrNotFound = errors.New("spotify: resource not found")
+	// ErrRateLimited indicates that the caller has been rate limited;
+	// see Error.RetryAfter for how long to wait before trying again.
+	ErrRateLimited = errors.New("spotify: rate limited")
+	// ErrPremiumRequired indicates that the requested operation needs a
+	// Spotify Premium subscription, as reported by the "PREMIUM_REQUIRED"
+	// reason on a player error.
+	ErrPremiumRequired = errors.New("spotify: premium required")
+)
+
+// decodeError decodes an Error from a Spotify API response, and fills in
+// request/response metadata - URL, method, raw body, and Retry-After - that
+// isn't part of the JSON error payload itself.
+func decodeError(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.New("spotify: couldn't read error response body")
+	}
+	var e struct {
+		E Error `json:"error"`
+	}
+	if err := json.Unmarshal(body, &e); err != nil {
+		return errors.New("spotify: couldn't decode error")
+	}
+	result := e.E
+	if result.Status == 0 {
+		result.Status = resp.StatusCode
+	}
+	result.Body = body
+	if resp.Request != nil {
+		result.URL = resp.Request.URL.String()
+		result.Method = resp.Request.Method
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			result.RetryAfter = time.Duration(secs) * time.Second
+		}
+	}
+	return result
+}
+
+// ExternalID contains information that identifies an item.
+type ExternalID struct {
+	// The identifier type, for example:
+	//   "isrc" - International Standard Recording Code
+	//   "ean"  - International Article Number
+	//   "upc"  - Universal Product Code
+	Key string `json:"{key}"`
+	// An external identifier for the object.
+	Value string `json:"{value}"`
+}
+
+// ExternalURL indicates an external, public URL for an item.
+type ExternalURL struct {
+	// The type of the URL, for example:
+	//    "spotify" - The Spotify URL for the object.
+	Key string `json:"{key}"`
+	// An external, public URL to the object.
+	Value string `json:"{value}"`
+}
+
+// Client is a client for working with the Spotify Web API.
+// To create an authenticated client, use the
+// `Authenticator.NewClient` method.  If you don't need to
+// authenticate, you can use `DefaultClient`.
+type Client struct {
+	http *http.Client
+	// scopes holds the set of authorization scopes the Client's token is
+	// known to have been granted.  It is nil for Clients that don't carry
+	// that information (DefaultClient, or a Client built by hand), in which
+	// case scope checks are skipped and left to the Web API to enforce.
+	scopes map[Scope]bool
+	// AutoRetry, when true, makes GET requests transparently retry instead
+	// of returning the error to the caller: a 429 response is retried after
+	// the duration in its Retry-After header, and a 5xx response is retried
+	// with an increasing backoff. It defaults to false, so existing callers
+	// see no change in behavior unless they opt in.
+	AutoRetry bool
+}
+
+// requireScope returns an ErrMissingScope if s is required and the Client
+// is known not to have been granted it.
+func (c *Client) requireScope(s Scope) error {
+	if c.scopes == nil {
+		return nil
+	}
+	if !c.scopes[s] {
+		return ErrMissingScope{Required: s}
+	}
+	return nil
+}
+
+// maxAutoRetries bounds how many times AutoRetry will retry a single
+// request, so a persistently failing endpoint can't retry forever.
+const maxAutoRetries = 3
+
+// get issues a GET request, transparently retrying it per AutoRetry.
+func (c *Client) get(url string) (*http.Response, error) {
+	return c.getContext(context.Background(), url)
+}
+
+// getContext is like get, but the wait between retries can be interrupted
+// by canceling ctx.
+func (c *Client) getContext(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !c.AutoRetry || attempt >= maxAutoRetries {
+			return resp, nil
+		}
+		var wait time.Duration
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			wait = retryAfter(resp.Header.Get("Retry-After"))
+		case resp.StatusCode >= http.StatusInternalServerError:
+			wait = time.Duration(1<<uint(attempt)) * 100 * time.Millisecond
+		default:
+			return resp, nil
+		}
+		resp.Body.Close()
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, in seconds, falling
+// back to a 1 second wait if the header is missing or malformed.
+func retryAfter(header string) time.Duration {
+	secs, err := strconv.Atoi(header)
+	if err != nil {
+		return time.Second
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// sleepContext waits for d, but returns early with ctx.Err() if ctx is
+// canceled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Options contains optional parameters that can be provided
+// to various API calls.  Only the non-nil fields are used
+// in queries.
+type Options struct {
+	// Country is an ISO 3166-1 alpha-2 country code.  Provide
+	// this parameter if you want the list of returned items to
+	// be relevant to a particular country.  If omitted, the
+	// results will be relevant to all countries.
+	Country *string
+	// Limit is the maximum number of items to return.
+	Limit *int
+	// Offset is the index of the first item to return.  Use it
+	// with Limit to get the next set of items.
+	Offset *int
+}
+
+// NewReleasesOpt is like NewReleases, but it accepts optional parameters
+// for filtering the results.
+func (c *Client) NewReleasesOpt(opt *Options) (albums *SimpleAlbumPage, err error) {
+	return c.NewReleasesOptWithContext(context.Background(), opt)
+}
+
+// NewReleasesOptWithContext is like NewReleasesOpt, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) NewReleasesOptWithContext(ctx context.Context, opt *Options) (albums *SimpleAlbumPage, err error) {
+	spotifyURL := baseAddress + "browse/new-releases"
+	if opt != nil {
+		v := url.Values{}
+		if opt.Country != nil {
+			v.Set("country", *opt.Country)
+		}
+		if opt.Limit != nil {
+			v.Set("limit", strconv.Itoa(*opt.Limit))
+		}
+		if opt.Offset != nil {
+			v.Set("offset", strconv.Itoa(*opt.Offset))
+		}
+		if params := v.Encode(); params != "" {
+			spotifyURL += "?" + params
+		}
+	}
+	resp, err := c.getContext(ctx, spotifyURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, decodeError(resp)
+	}
+	var result SimpleAlbumPage
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// NewReleases gets a list of new album releases featured in Spotify.
+// This call requires bearer authorization.
+func (c *Client) NewReleases() (albums *SimpleAlbumPage, err error) {
+	return c.NewReleasesOpt(nil)
+}
+
+// NewReleasesWithContext is like NewReleases, but it accepts a
+// context.Context that can be used to cancel or time out the request.
+func (c *Client) NewReleasesWithContext(ctx context.Context) (albums *SimpleAlbumPage, err error) {
+	return c.NewReleasesOptWithContext(ctx, nil)
+}
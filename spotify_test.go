@@ -0,0 +1,295 @@
+// Copyright 2014, 2015 Zac Bergquist
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spotify
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+type stringRoundTripper struct {
+	strings.Reader
+	statusCode  int
+	lastRequest *http.Request
+}
+
+func newStringRoundTripper(code int, s string) *stringRoundTripper {
+	return &stringRoundTripper{*strings.NewReader(s), code, nil}
+}
+
+func (s stringRoundTripper) Close() error {
+	return nil
+}
+
+type fileRoundTripper struct {
+	*os.File
+	statusCode  int
+	lastRequest *http.Request
+}
+
+func newFileRoundTripper(code int, filename string) *fileRoundTripper {
+	file, err := os.Open(filename)
+	if err != nil {
+		panic("Couldn't open file " + filename)
+	}
+	return &fileRoundTripper{file, code, nil}
+}
+
+func (s *stringRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.lastRequest = req
+	if req.Header == nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, errors.New("stringRoundTripper: nil request header")
+	}
+	return &http.Response{
+		StatusCode: s.statusCode,
+		Body:       s,
+		Request:    req,
+	}, nil
+}
+
+func (f *fileRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.lastRequest = req
+	if req.Header == nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, errors.New("fileRoundTripper: nil request header")
+	}
+	return &http.Response{
+		StatusCode: f.statusCode,
+		Body:       f,
+		Request:    req,
+	}, nil
+}
+
+// sequenceRoundTripper returns a different canned response for each
+// successive request, which is useful for exercising AutoRetry: the first
+// response(s) can simulate a 429 or 5xx, and the last a successful reply.
+type sequenceRoundTripper struct {
+	responses []*http.Response
+	next      int
+}
+
+func newSequenceRoundTripper(responses ...*http.Response) *sequenceRoundTripper {
+	return &sequenceRoundTripper{responses: responses}
+}
+
+func (s *sequenceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if s.next >= len(s.responses) {
+		return nil, errors.New("sequenceRoundTripper: ran out of canned responses")
+	}
+	resp := s.responses[s.next]
+	s.next++
+	resp.Request = req
+	return resp, nil
+}
+
+// Returns a client whose requests will always return
+// the specified status code and body.
+func testClientString(code int, body string) *Client {
+	return &Client{
+		http: &http.Client{
+			Transport: newStringRoundTripper(code, body),
+		},
+	}
+}
+
+// Returns a client whose requests will always return
+// a response with the specified status code and a body
+// that is read from the specified file.
+func testClientFile(code int, filename string) *Client {
+	return &Client{
+		http: &http.Client{
+			Transport: newFileRoundTripper(code, filename),
+		},
+	}
+}
+
+func getLastRequest(c *Client) *http.Request {
+	if frt, ok := c.http.Transport.(*fileRoundTripper); ok {
+		return frt.lastRequest
+	}
+	if srt, ok := c.http.Transport.(*stringRoundTripper); ok {
+		return srt.lastRequest
+	}
+	return nil
+}
+
+// addDummyAuth puts fake authorization data in the specified
+// client, which allows the basic authentication checks to pass
+// for the purpose of testing
+func addDummyAuth(c *Client) {
+	c.scopes = map[Scope]bool{
+		ScopePlaylistReadPrivate:       true,
+		ScopePlaylistModifyPublic:      true,
+		ScopePlaylistModifyPrivate:     true,
+		ScopePlaylistReadCollaborative: true,
+		ScopeUserFollowModify:          true,
+		ScopeUserFollowRead:            true,
+		ScopeUserLibraryModify:         true,
+		ScopeUserLibraryRead:           true,
+		ScopeUserReadPrivate:           true,
+		ScopeUserReadEmail:             true,
+		ScopeUserReadBirthdate:         true,
+		ScopeUGCImageUpload:            true,
+		ScopeStreaming:                 true,
+		ScopeUserTopRead:               true,
+		ScopeUserReadRecentlyPlayed:    true,
+		ScopeUserReadPlaybackState:     true,
+		ScopeUserModifyPlaybackState:   true,
+		ScopeUserReadCurrentlyPlaying:  true,
+		ScopeUserReadPlaybackPosition:  true,
+	}
+}
+
+func TestNewReleases(t *testing.T) {
+	c := testClientFile(http.StatusOK, "test_data/new_releases.txt")
+	addDummyAuth(c)
+	_, err := c.NewReleases()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+}
+
+// headerRoundTripper is like stringRoundTripper, but also lets a test set
+// response headers - needed to exercise the Retry-After parsing in
+// decodeError.
+type headerRoundTripper struct {
+	statusCode int
+	header     http.Header
+	body       string
+}
+
+func (h headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: h.statusCode,
+		Header:     h.header,
+		Body:       io.NopCloser(strings.NewReader(h.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestErrorRateLimited(t *testing.T) {
+	json := `{ "error": { "status": 429, "message": "slow down" } }`
+	rt := headerRoundTripper{
+		statusCode: http.StatusTooManyRequests,
+		header:     http.Header{"Retry-After": []string{"2"}},
+		body:       json,
+	}
+	c := &Client{http: &http.Client{Transport: rt}}
+
+	_, err := c.CurrentUser()
+	serr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("Expected Error, got %#v", err)
+	}
+	if serr.RetryAfter != 2*time.Second {
+		t.Errorf("Expected RetryAfter of 2s, got %s", serr.RetryAfter)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Error("Expected errors.Is(err, ErrRateLimited) to be true")
+	}
+}
+
+func TestErrorPremiumRequired(t *testing.T) {
+	json := `{ "error": { "status": 403, "message": "Player command failed", "reason": "PREMIUM_REQUIRED" } }`
+	rt := headerRoundTripper{statusCode: http.StatusForbidden, body: json}
+	c := &Client{http: &http.Client{Transport: rt}}
+
+	_, err := c.CurrentUser()
+	serr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("Expected Error, got %#v", err)
+	}
+	if serr.Reason != "PREMIUM_REQUIRED" {
+		t.Errorf("Expected reason PREMIUM_REQUIRED, got %s", serr.Reason)
+	}
+	if !errors.Is(err, ErrPremiumRequired) {
+		t.Error("Expected errors.Is(err, ErrPremiumRequired) to be true")
+	}
+}
+
+func TestAutoRetryOn429(t *testing.T) {
+	rt := newSequenceRoundTripper(
+		&http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"0"}},
+			Body:       io.NopCloser(strings.NewReader(`{ "error": { "status": 429, "message": "slow down" } }`)),
+		},
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(userResponse)),
+		},
+	)
+	c := &Client{http: &http.Client{Transport: rt}, AutoRetry: true}
+
+	user, err := c.GetUsersPublicProfile("wizzler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "wizzler" {
+		t.Error("Expected user wizzler, got ", user.ID)
+	}
+	if rt.next != 2 {
+		t.Errorf("Expected 2 requests, got %d", rt.next)
+	}
+}
+
+func TestAutoRetryDisabledByDefault(t *testing.T) {
+	rt := newSequenceRoundTripper(
+		&http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"0"}},
+			Body:       io.NopCloser(strings.NewReader(`{ "error": { "status": 429, "message": "slow down" } }`)),
+		},
+	)
+	c := &Client{http: &http.Client{Transport: rt}}
+
+	_, err := c.GetUsersPublicProfile("wizzler")
+	if !errors.Is(err, ErrRateLimited) {
+		t.Error("Expected a 429 to be returned to the caller when AutoRetry is false")
+	}
+}
+
+func TestAutoRetryOn5xx(t *testing.T) {
+	rt := newSequenceRoundTripper(
+		&http.Response{
+			StatusCode: http.StatusBadGateway,
+			Body:       io.NopCloser(strings.NewReader("")),
+		},
+		&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(userResponse)),
+		},
+	)
+	c := &Client{http: &http.Client{Transport: rt}, AutoRetry: true}
+
+	_, err := c.GetUsersPublicProfile("wizzler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rt.next != 2 {
+		t.Errorf("Expected 2 requests, got %d", rt.next)
+	}
+}
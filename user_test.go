@@ -15,8 +15,11 @@
 package spotify
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -56,6 +59,21 @@ func TestUserProfile(t *testing.T) {
 	}
 }
 
+type contextKey string
+
+func TestUserProfileWithContext(t *testing.T) {
+	client := testClientString(http.StatusOK, userResponse)
+	ctx := context.WithValue(context.Background(), contextKey("request-id"), "abc123")
+	_, err := client.GetUsersPublicProfileWithContext(ctx, "wizzler")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := getLastRequest(client)
+	if got := req.Context().Value(contextKey("request-id")); got != "abc123" {
+		t.Errorf("Expected the request's context to carry the caller's ctx, got %v", got)
+	}
+}
+
 func TestCurrentUser(t *testing.T) {
 	json := `{
 		"country" : "US",
@@ -110,6 +128,15 @@ func TestFollowUsersMissingScope(t *testing.T) {
 		if serr.Status != http.StatusForbidden {
 			t.Error("Expected HTTP 403")
 		}
+		if serr.Method != "PUT" {
+			t.Error("Expected method PUT, got", serr.Method)
+		}
+		if !strings.Contains(serr.URL, "me/following") {
+			t.Error("Expected URL to contain 'me/following', got", serr.URL)
+		}
+		if len(serr.Body) == 0 {
+			t.Error("Expected the raw response body to be preserved")
+		}
 	}
 }
 
@@ -131,6 +158,22 @@ func TestFollowUsersInvalidToken(t *testing.T) {
 			t.Error("Expected HTTP 401")
 		}
 	}
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrRateLimited) || errors.Is(err, ErrPremiumRequired) {
+		t.Error("A 401 shouldn't match any of the unrelated sentinel errors")
+	}
+}
+
+func TestFollowWithContext(t *testing.T) {
+	client := testClientString(http.StatusNoContent, "")
+	addDummyAuth(client)
+	ctx := context.WithValue(context.Background(), contextKey("request-id"), "abc123")
+	if err := client.FollowWithContext(ctx, ID("exampleuser01")); err != nil {
+		t.Fatal(err)
+	}
+	req := getLastRequest(client)
+	if got := req.Context().Value(contextKey("request-id")); got != "abc123" {
+		t.Errorf("Expected the request's context to carry the caller's ctx, got %v", got)
+	}
 }
 
 func TestUserFollows(t *testing.T) {